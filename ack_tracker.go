@@ -0,0 +1,135 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+// AckTracker tracks integer offsets acknowledged out of order. Offsets up to the low
+// watermark are all acknowledged and are represented by the watermark alone, while
+// acknowledged offsets above it are kept as a merged RangeCollection of islands, so the
+// memory used depends on the number of gaps rather than the number of acknowledgements.
+// An AckTracker is safe for concurrent use.
+type AckTracker struct {
+	mutex     sync.Mutex
+	watermark float64
+	islands   RangeCollection
+}
+
+// NewAckTracker creates an AckTracker where start is the first offset expected to be
+// acknowledged
+func NewAckTracker(start float64) (*AckTracker, error) {
+	if math.IsInf(start, 0) || start != math.Trunc(start) {
+		return nil, errors.New(fmt.Sprintf("Invalid start offset: %v", start))
+	}
+
+	return &AckTracker{watermark: start - 1, islands: RangeCollection{}}, nil
+}
+
+// Ack acknowledges a single offset
+func (tracker *AckTracker) Ack(offset float64) error {
+	return tracker.AckRange(Range{Start: offset, End: offset})
+}
+
+// AckRange acknowledges every offset in grange. Offsets that were already acknowledged
+// are ignored.
+func (tracker *AckTracker) AckRange(grange Range) error {
+	if err := checkIntegerRange(grange); err != nil {
+		return err
+	}
+
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	if grange.End <= tracker.watermark {
+		return nil
+	}
+	grange.Start = math.Max(grange.Start, tracker.watermark+1)
+
+	// Replace the islands touching grange with a single island covering all of them
+	first := sort.Search(len(tracker.islands), func(i int) bool {
+		return tracker.islands[i].End+1 >= grange.Start
+	})
+	last := first
+	for ; last < len(tracker.islands) && tracker.islands[last].Start <= grange.End+1; last++ {
+		grange.Start = math.Min(grange.Start, tracker.islands[last].Start)
+		grange.End = math.Max(grange.End, tracker.islands[last].End)
+	}
+
+	// Update the islands in place, so that extending an island costs constant time
+	if first == last {
+		tracker.islands = append(tracker.islands, Range{})
+		copy(tracker.islands[first+1:], tracker.islands[first:])
+		tracker.islands[first] = grange
+	} else {
+		tracker.islands[first] = grange
+		tracker.islands = append(tracker.islands[:first+1], tracker.islands[last:]...)
+	}
+
+	if tracker.islands[0].Start == tracker.watermark+1 {
+		tracker.watermark = tracker.islands[0].End
+		tracker.islands = tracker.islands[1:]
+	}
+
+	return nil
+}
+
+// LowWatermark returns the highest offset such that it and every offset before it have
+// been acknowledged, or one less than the start offset if the start offset has not been
+// acknowledged
+func (tracker *AckTracker) LowWatermark() float64 {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	return tracker.watermark
+}
+
+// HighWatermark returns the highest acknowledged offset, or one less than the start
+// offset if no offsets have been acknowledged
+func (tracker *AckTracker) HighWatermark() float64 {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	if len(tracker.islands) == 0 {
+		return tracker.watermark
+	}
+
+	return tracker.islands[len(tracker.islands)-1].End
+}
+
+// Acked returns the merged RangeCollection of acknowledged offsets above the low
+// watermark
+func (tracker *AckTracker) Acked() RangeCollection {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	return NewRangeCollection(tracker.islands)
+}
+
+// Pending returns the merged RangeCollection of offsets that have not been acknowledged
+// between the low and high watermarks
+func (tracker *AckTracker) Pending() RangeCollection {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	pending := RangeCollection{}
+	start := tracker.watermark + 1
+
+	for _, island := range tracker.islands {
+		pending = append(pending, Range{Start: start, End: island.Start - 1})
+		start = island.End + 1
+	}
+
+	return pending
+}
+
+// IsAcked tests if an offset has been acknowledged
+func (tracker *AckTracker) IsAcked(offset float64) bool {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	return offset <= tracker.watermark || containsRange(tracker.islands, Range{Start: offset, End: offset})
+}
@@ -0,0 +1,66 @@
+package gorange
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// ACKNOWLEDGING:
+// Tracks the low watermark and pending gaps
+func TestAckTracker(t *testing.T) {
+	tracker, err := NewAckTracker(100)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	tracker.Ack(101)
+	tracker.AckRange(Range{Start: 105, End: 107})
+	tracker.Ack(104)
+
+	if tracker.LowWatermark() != 99 || tracker.HighWatermark() != 107 {
+		t.Errorf("Failed! Expected watermarks 99 and 107, Got: %v and %v", tracker.LowWatermark(), tracker.HighWatermark())
+	}
+
+	expectedPending := RangeCollection{Range{Start: 100, End: 100}, Range{Start: 102, End: 103}}
+	if pending := tracker.Pending(); !pending.Equal(expectedPending) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedPending, pending)
+	}
+
+	tracker.Ack(100)
+	tracker.AckRange(Range{Start: 98, End: 102})
+
+	expectedAcked := RangeCollection{Range{Start: 104, End: 107}}
+	if tracker.LowWatermark() != 102 || !tracker.Acked().Equal(expectedAcked) {
+		t.Errorf("Failed! Expected watermark 102 and %v, Got: %v and %v", expectedAcked, tracker.LowWatermark(), tracker.Acked())
+	}
+
+	if !tracker.IsAcked(50) || !tracker.IsAcked(105) || tracker.IsAcked(103) || tracker.IsAcked(108) {
+		t.Errorf("Failed! IsAcked did not match %v", tracker.Acked())
+	}
+
+	if err := tracker.Ack(1.5); err == nil {
+		t.Errorf("Failed! Non-integer offset should be rejected")
+	}
+}
+
+// Stays small after many out of order acknowledgements
+func TestAckTrackerMemory(t *testing.T) {
+	const count = 1000000
+	tracker, _ := NewAckTracker(0)
+	random := rand.New(rand.NewSource(1))
+
+	// Acknowledge offsets shuffled within a sliding window, like parallel consumers
+	for base := 0; base < count; base += 64 {
+		for _, i := range random.Perm(64) {
+			tracker.Ack(float64(base + i))
+		}
+
+		if len(tracker.Acked()) > 32 {
+			t.Fatalf("Failed! Too many islands: %v", len(tracker.Acked()))
+		}
+	}
+
+	if tracker.LowWatermark() != count-1 || len(tracker.Acked()) != 0 || len(tracker.Pending()) != 0 {
+		t.Errorf("Failed! Expected watermark %v, Got: %v", count-1, tracker.LowWatermark())
+	}
+}
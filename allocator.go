@@ -0,0 +1,348 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// AllocationPolicy chooses which free Range an Allocator allocates from
+type AllocationPolicy int
+
+const (
+	// FirstFit allocates from the lowest free Range large enough for the request
+	FirstFit AllocationPolicy = iota
+	// BestFit allocates from the smallest free Range large enough for the request,
+	// keeping large free Ranges intact for later contiguous requests
+	BestFit
+)
+
+var allocationPolicyNames = map[AllocationPolicy]string{
+	FirstFit: "first-fit",
+	BestFit:  "best-fit",
+}
+
+// String returns the name of an AllocationPolicy
+func (policy AllocationPolicy) String() string {
+	if name, ok := allocationPolicyNames[policy]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("AllocationPolicy(%d)", int(policy))
+}
+
+// ErrAllocatorExhausted is returned when an Allocator has no free Range large enough
+// for a request
+var ErrAllocatorExhausted = errors.New("No free range large enough")
+
+// Allocator allocates integer IDs from a pool of Ranges, tracking the free IDs as a
+// merged RangeCollection. IDs may be reserved so that they are never allocated. An
+// Allocator is safe for concurrent use.
+type Allocator struct {
+	mutex    sync.Mutex
+	policy   AllocationPolicy
+	pool     RangeCollection
+	reserved RangeCollection
+	free     RangeCollection
+}
+
+// NewAllocator creates an Allocator that allocates IDs from the supplied pool using
+// policy. It will return an error if any Range in the pool is unbounded or does not
+// start and end on integers.
+func NewAllocator(pool RangeCollection, policy AllocationPolicy) (*Allocator, error) {
+	if _, ok := allocationPolicyNames[policy]; !ok {
+		return nil, errors.New(fmt.Sprintf("Unknown allocation policy: %v", policy))
+	}
+
+	for _, grange := range pool {
+		if err := checkIntegerRange(grange); err != nil {
+			return nil, err
+		}
+	}
+
+	merged := pool.MergeIntegers()
+
+	return &Allocator{
+		policy:   policy,
+		pool:     merged,
+		reserved: RangeCollection{},
+		free:     NewRangeCollection(merged),
+	}, nil
+}
+
+// Allocate allocates a single ID
+func (a *Allocator) Allocate() (float64, error) {
+	grange, err := a.AllocateN(1)
+	return grange.Start, err
+}
+
+// AllocateN allocates a Range of n contiguous IDs. It will return ErrAllocatorExhausted
+// if no free Range holds n IDs.
+func (a *Allocator) AllocateN(n int) (Range, error) {
+	if n <= 0 {
+		return Range{}, errors.New(fmt.Sprintf("Invalid allocation size: %d", n))
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	size := float64(n)
+	chosen := -1
+
+	for i, grange := range a.free {
+		length := grange.End - grange.Start + 1
+		if length < size {
+			continue
+		}
+
+		if a.policy == FirstFit {
+			chosen = i
+			break
+		} else if chosen == -1 || length < a.free[chosen].End-a.free[chosen].Start+1 {
+			chosen = i
+		}
+	}
+
+	if chosen == -1 {
+		return Range{}, ErrAllocatorExhausted
+	}
+
+	allocated := Range{Start: a.free[chosen].Start, End: a.free[chosen].Start + size - 1}
+	a.free = removeIntegers(a.free, allocated)
+
+	return allocated, nil
+}
+
+// AllocateSpecific allocates every ID in grange. It will return an error if any of the
+// IDs are not free.
+func (a *Allocator) AllocateSpecific(grange Range) error {
+	if err := checkIntegerRange(grange); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !containsRange(a.free, grange) {
+		return errors.New(fmt.Sprintf("Range %v is not free", grange))
+	}
+
+	a.free = removeIntegers(a.free, grange)
+	return nil
+}
+
+// Release returns every ID in grange to the free Ranges. It will return an error if any
+// of the IDs are not allocated.
+func (a *Allocator) Release(grange Range) error {
+	if err := checkIntegerRange(grange); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !containsRange(a.allocated(), grange) {
+		return errors.New(fmt.Sprintf("Range %v is not allocated", grange))
+	}
+
+	a.free = append(a.free, grange).MergeIntegers()
+	return nil
+}
+
+// Reserve reserves every ID in grange so that it is never allocated. It will return an
+// error if any of the IDs are outside the pool or allocated.
+func (a *Allocator) Reserve(grange Range) error {
+	if err := checkIntegerRange(grange); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !containsRange(a.pool, grange) {
+		return errors.New(fmt.Sprintf("Range %v is outside the pool", grange))
+	} else if len(a.allocated().Intersect(RangeCollection{grange})) > 0 {
+		return errors.New(fmt.Sprintf("Range %v is allocated", grange))
+	}
+
+	a.reserved = append(a.reserved, grange).MergeIntegers()
+	a.free = removeIntegers(a.free, grange)
+	return nil
+}
+
+// Unreserve returns every ID in grange to the free Ranges. It will return an error if any
+// of the IDs are not reserved.
+func (a *Allocator) Unreserve(grange Range) error {
+	if err := checkIntegerRange(grange); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !containsRange(a.reserved, grange) {
+		return errors.New(fmt.Sprintf("Range %v is not reserved", grange))
+	}
+
+	a.reserved = removeIntegers(a.reserved, grange)
+	a.free = append(a.free, grange).MergeIntegers()
+	return nil
+}
+
+// Free returns the merged RangeCollection of free IDs
+func (a *Allocator) Free() RangeCollection {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return NewRangeCollection(a.free)
+}
+
+// Allocated returns the merged RangeCollection of allocated IDs
+func (a *Allocator) Allocated() RangeCollection {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.allocated()
+}
+
+// Reserved returns the merged RangeCollection of reserved IDs
+func (a *Allocator) Reserved() RangeCollection {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return NewRangeCollection(a.reserved)
+}
+
+func (a *Allocator) allocated() RangeCollection {
+	allocated := NewRangeCollection(a.pool)
+	for _, grange := range append(NewRangeCollection(a.reserved), a.free...) {
+		allocated = removeIntegers(allocated, grange)
+	}
+
+	return allocated
+}
+
+// Snapshot renders the state of the Allocator as text that can be passed to
+// RestoreAllocator, with one line for the policy and one line for each pool, reserved
+// and allocated Range
+func (a *Allocator) Snapshot() string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	builder := strings.Builder{}
+	builder.WriteString("policy " + a.policy.String() + "\n")
+
+	for _, section := range []struct {
+		name       string
+		collection RangeCollection
+	}{{"pool", a.pool}, {"reserved", a.reserved}, {"allocated", a.allocated()}} {
+		for _, grange := range section.collection {
+			builder.WriteString(section.name + " " + grange.Format(":") + "\n")
+		}
+	}
+
+	return builder.String()
+}
+
+// RestoreAllocator creates an Allocator from the text produced by Allocator.Snapshot,
+// ignoring blank lines
+func RestoreAllocator(snapshot string) (*Allocator, error) {
+	policy := FirstFit
+	sections := map[string]RangeCollection{}
+
+	for i, line := range strings.Split(snapshot, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		} else if len(fields) != 2 {
+			return nil, errors.New(fmt.Sprintf("Line %d: expected a name and a value, found %q", i+1, line))
+		}
+
+		switch fields[0] {
+		case "policy":
+			found := false
+			for candidate, name := range allocationPolicyNames {
+				if name == fields[1] {
+					policy, found = candidate, true
+				}
+			}
+
+			if !found {
+				return nil, errors.New(fmt.Sprintf("Line %d: unknown allocation policy %q", i+1, fields[1]))
+			}
+		case "pool", "reserved", "allocated":
+			grange, err := ParseRange(fields[1], ":")
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("Line %d: %v", i+1, err))
+			}
+			sections[fields[0]] = append(sections[fields[0]], grange)
+		default:
+			return nil, errors.New(fmt.Sprintf("Line %d: unknown section %q", i+1, fields[0]))
+		}
+	}
+
+	allocator, err := NewAllocator(sections["pool"], policy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grange := range sections["reserved"] {
+		if err := allocator.Reserve(grange); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, grange := range sections["allocated"] {
+		if err := allocator.AllocateSpecific(grange); err != nil {
+			return nil, err
+		}
+	}
+
+	return allocator, nil
+}
+
+func checkIntegerRange(grange Range) error {
+	if math.IsInf(grange.Start, 0) || math.IsInf(grange.End, 0) {
+		return errors.New(fmt.Sprintf("Range %v is unbounded", grange))
+	} else if grange.Start != math.Trunc(grange.Start) || grange.End != math.Trunc(grange.End) {
+		return errors.New(fmt.Sprintf("Range %v does not have integer bounds", grange))
+	} else if grange.Start > grange.End {
+		return errors.New(fmt.Sprintf("Start of range %v is after its end", grange))
+	}
+
+	return nil
+}
+
+// containsRange tests if a single Range of a merged RangeCollection contains grange
+func containsRange(collection RangeCollection, grange Range) bool {
+	index := sort.Search(len(collection), func(i int) bool {
+		return collection[i].End >= grange.End
+	})
+
+	return index < len(collection) && collection[index].Start <= grange.Start
+}
+
+// removeIntegers removes the integers in grange from a merged RangeCollection of integer
+// Ranges
+func removeIntegers(collection RangeCollection, grange Range) RangeCollection {
+	remaining := RangeCollection{}
+
+	for _, existing := range collection {
+		if !existing.Overlap(grange) {
+			remaining = append(remaining, existing)
+			continue
+		}
+
+		if existing.Start < grange.Start {
+			remaining = append(remaining, Range{Start: existing.Start, End: grange.Start - 1})
+		}
+		if existing.End > grange.End {
+			remaining = append(remaining, Range{Start: grange.End + 1, End: existing.End})
+		}
+	}
+
+	return remaining
+}
@@ -0,0 +1,110 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+// ALLOCATING:
+// Allocates single and contiguous IDs around reservations
+func TestAllocator(t *testing.T) {
+	allocator, err := NewAllocator(RangeCollection{Range{Start: 1000, End: 1009}}, FirstFit)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	if err := allocator.Reserve(Range{Start: 1000, End: 1001}); err != nil {
+		t.Errorf("Failed! Error: %v", err)
+	}
+
+	if id, err := allocator.Allocate(); id != 1002 || err != nil {
+		t.Errorf("Failed! Expected: 1002, Got: %v, Error: %v", id, err)
+	}
+
+	if grange, err := allocator.AllocateN(3); !grange.Equal(Range{Start: 1003, End: 1005}) || err != nil {
+		t.Errorf("Failed! Expected: 1003:1005, Got: %v, Error: %v", grange, err)
+	}
+
+	if _, err := allocator.AllocateN(5); err != ErrAllocatorExhausted {
+		t.Errorf("Failed! Expected ErrAllocatorExhausted, Got: %v", err)
+	}
+
+	if err := allocator.Release(Range{Start: 1003, End: 1004}); err != nil {
+		t.Errorf("Failed! Error: %v", err)
+	}
+
+	expectedFree := RangeCollection{Range{Start: 1003, End: 1004}, Range{Start: 1006, End: 1009}}
+	if free := allocator.Free(); !free.Equal(expectedFree) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedFree, free)
+	}
+
+	expectedAllocated := RangeCollection{Range{Start: 1002, End: 1002}, Range{Start: 1005, End: 1005}}
+	if allocated := allocator.Allocated(); !allocated.Equal(expectedAllocated) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedAllocated, allocated)
+	}
+}
+
+// Chooses free ranges by policy
+func TestAllocationPolicies(t *testing.T) {
+	pool := RangeCollection{Range{Start: 0, End: 9}, Range{Start: 20, End: 22}}
+	tests := map[AllocationPolicy]float64{FirstFit: 0, BestFit: 20}
+
+	for policy, expected := range tests {
+		allocator, _ := NewAllocator(pool, policy)
+		if grange, err := allocator.AllocateN(2); grange.Start != expected || err != nil {
+			t.Errorf("Failed! %v expected to start at %v, Got: %v, Error: %v", policy, expected, grange, err)
+		}
+	}
+}
+
+// Allocates specific IDs and rejects invalid requests
+func TestAllocateSpecific(t *testing.T) {
+	allocator, _ := NewAllocator(RangeCollection{Range{Start: 1, End: 10}}, FirstFit)
+
+	if err := allocator.AllocateSpecific(Range{Start: 4, End: 6}); err != nil {
+		t.Errorf("Failed! Error: %v", err)
+	}
+
+	failures := []func() error{
+		func() error { return allocator.AllocateSpecific(Range{Start: 6, End: 7}) },
+		func() error { return allocator.AllocateSpecific(Range{Start: 10, End: 11}) },
+		func() error { return allocator.AllocateSpecific(Range{Start: 1.5, End: 2}) },
+		func() error { return allocator.Release(Range{Start: 3, End: 4}) },
+		func() error { return allocator.Reserve(Range{Start: 5, End: 5}) },
+		func() error { return allocator.Unreserve(Range{Start: 1, End: 1}) },
+	}
+
+	for i, failure := range failures {
+		if err := failure(); err == nil {
+			t.Errorf("Failed! Request %d should have failed", i)
+		}
+	}
+
+	if _, err := NewAllocator(RangeCollection{Range{Start: 0, End: math.Inf(1)}}, FirstFit); err == nil {
+		t.Errorf("Failed! Unbounded pool should be rejected")
+	}
+}
+
+// SNAPSHOTS:
+// Snapshots and restores allocator state
+func TestAllocatorSnapshot(t *testing.T) {
+	allocator, _ := NewAllocator(RangeCollection{Range{Start: 1, End: 100}}, BestFit)
+	allocator.Reserve(Range{Start: 1, End: 9})
+	allocator.AllocateSpecific(Range{Start: 50, End: 50})
+	allocator.AllocateN(5)
+
+	expected := "policy best-fit\npool 1:100\nreserved 1:9\nallocated 10:14\nallocated 50\n"
+	snapshot := allocator.Snapshot()
+	if snapshot != expected {
+		t.Errorf("Failed! Expected: %q, Got: %q", expected, snapshot)
+	}
+
+	restored, err := RestoreAllocator(snapshot)
+	if err != nil || restored.Snapshot() != expected || !restored.Free().Equal(allocator.Free()) {
+		t.Errorf("Failed! Expected: %q, Got: %v, Error: %v", expected, restored, err)
+	}
+
+	if _, err := RestoreAllocator("policy worst-fit\n"); err == nil {
+		t.Errorf("Failed! Unknown policy should be rejected")
+	}
+}
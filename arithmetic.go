@@ -0,0 +1,318 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// The methods in this file treat a Range as an interval number containing every real
+// value between Start and End. Results are rounded outward, so that the result of an
+// operation always contains the exact result of the operation on any values of its
+// operands, and bounds are only widened when the floating point result is inexact.
+
+// Add returns the interval containing the sum of any values of two intervals
+func (r Range) Add(other Range) Range {
+	return Range{Start: addDown(r.Start, other.Start), End: addUp(r.End, other.End)}
+}
+
+// Sub returns the interval containing the difference of any values of two intervals
+func (r Range) Sub(other Range) Range {
+	return Range{Start: addDown(r.Start, -other.End), End: addUp(r.End, -other.Start)}
+}
+
+// Mul returns the interval containing the product of any values of two intervals. Zero
+// multiplied by an infinite bound is treated as zero.
+func (r Range) Mul(other Range) Range {
+	pairs := [][2]float64{{r.Start, other.Start}, {r.Start, other.End}, {r.End, other.Start}, {r.End, other.End}}
+
+	start, end := math.Inf(1), math.Inf(-1)
+	for _, pair := range pairs {
+		start = math.Min(start, mulDown(pair[0], pair[1]))
+		end = math.Max(end, mulUp(pair[0], pair[1]))
+	}
+
+	return Range{Start: start, End: end}
+}
+
+// Div returns the merged RangeCollection containing the quotient of any values of two
+// intervals. When the divisor contains zero the quotient is unbounded, and is split into
+// two Ranges if the dividend does not contain zero. It will return an error if the
+// divisor is exactly zero.
+func (r Range) Div(other Range) (RangeCollection, error) {
+	if other.Start == 0 && other.End == 0 {
+		return RangeCollection{}, errors.New(fmt.Sprintf("Range %v divided by zero", r))
+	}
+
+	if !other.Contains(0) {
+		start, end := math.Inf(1), math.Inf(-1)
+		for _, dividend := range []float64{r.Start, r.End} {
+			for _, divisor := range []float64{other.Start, other.End} {
+				// Infinite bounds divided by each other are NaN and bound nothing
+				if down := divDown(dividend, divisor); !math.IsNaN(down) {
+					start = math.Min(start, down)
+					end = math.Max(end, divUp(dividend, divisor))
+				}
+			}
+		}
+
+		return RangeCollection{Range{Start: start, End: end}}, nil
+	}
+
+	negative, positive := math.Inf(-1), math.Inf(1)
+	if r.Contains(0) {
+		return RangeCollection{Range{Start: negative, End: positive}}, nil
+	}
+
+	// Dividing by values approaching zero from either side sends the quotient toward an
+	// infinity, so each side of the divisor contributes one unbounded Range
+	collection := RangeCollection{}
+	if r.End < 0 {
+		if other.End > 0 {
+			collection = append(collection, Range{Start: negative, End: divUp(r.End, other.End)})
+		}
+		if other.Start < 0 {
+			collection = append(collection, Range{Start: divDown(r.End, other.Start), End: positive})
+		}
+	} else {
+		if other.Start < 0 {
+			collection = append(collection, Range{Start: negative, End: divUp(r.Start, other.Start)})
+		}
+		if other.End > 0 {
+			collection = append(collection, Range{Start: divDown(r.Start, other.End), End: positive})
+		}
+	}
+
+	return collection.Merge(), nil
+}
+
+// Pow returns the interval containing any value of an interval raised to the power of n.
+// It will return an error if n is negative, since the result may need to be split; use
+// Div to divide one by the result instead.
+func (r Range) Pow(n int) (Range, error) {
+	if n < 0 {
+		return r, errors.New(fmt.Sprintf("Invalid power: %d", n))
+	} else if n == 0 {
+		return Range{Start: 1, End: 1}, nil
+	}
+
+	if n%2 == 1 {
+		return Range{Start: signedPowDown(r.Start, n), End: -signedPowDown(-r.End, n)}, nil
+	}
+
+	low, high := math.Abs(r.Start), math.Abs(r.End)
+	if low > high {
+		low, high = high, low
+	}
+	if r.Contains(0) {
+		low = 0
+	}
+
+	return Range{Start: powDown(low, n), End: powUp(high, n)}, nil
+}
+
+// Sqrt returns the interval containing the square root of any non-negative value of an
+// interval. It will return an error if the interval has no non-negative values.
+func (r Range) Sqrt() (Range, error) {
+	if r.End < 0 {
+		return r, errors.New(fmt.Sprintf("Range %v has no square root", r))
+	}
+
+	return Range{Start: sqrtDown(math.Max(r.Start, 0)), End: sqrtUp(r.End)}, nil
+}
+
+// Abs returns the interval containing the absolute value of any value of an interval
+func (r Range) Abs() Range {
+	if r.Start >= 0 {
+		return r
+	} else if r.End <= 0 {
+		return Range{Start: -r.End, End: -r.Start}
+	}
+
+	return Range{Start: 0, End: math.Max(-r.Start, r.End)}
+}
+
+// Min returns the interval containing the minimum of any values of two intervals
+func (r Range) Min(other Range) Range {
+	return Range{Start: math.Min(r.Start, other.Start), End: math.Min(r.End, other.End)}
+}
+
+// Max returns the interval containing the maximum of any values of two intervals
+func (r Range) Max(other Range) Range {
+	return Range{Start: math.Max(r.Start, other.Start), End: math.Max(r.End, other.End)}
+}
+
+// Each of the following functions computes a rounded result along with the sign of its
+// rounding error, the exact result minus the rounded result, and moves the result one
+// representable value outward when the error points outward. Errors involving infinite
+// operands are NaN and never move the result.
+
+// minExactError is the smallest magnitude of a product, dividend or square for which the
+// rounding error computed with FMA cannot underflow. Results of smaller values may be
+// exact, but are moved outward regardless since the sign of their error is unknown.
+const minExactError = 0x1p-969
+
+func roundDown(result float64, err float64) float64 {
+	if err < 0 {
+		return math.Nextafter(result, math.Inf(-1))
+	}
+
+	return result
+}
+
+func roundUp(result float64, err float64) float64 {
+	if err > 0 {
+		return math.Nextafter(result, math.Inf(1))
+	}
+
+	return result
+}
+
+// underflowDown returns a lower bound of a result that may have underflowed. A positive
+// zero is kept since the exact result is positive.
+func underflowDown(result float64) float64 {
+	if result == 0 && !math.Signbit(result) {
+		return 0
+	}
+
+	return math.Nextafter(result, math.Inf(-1))
+}
+
+func underflowUp(result float64) float64 {
+	if result == 0 && math.Signbit(result) {
+		return 0
+	}
+
+	return math.Nextafter(result, math.Inf(1))
+}
+
+// sumError returns the exact rounding error of a sum using Knuth's TwoSum
+func sumError(a float64, b float64, sum float64) float64 {
+	if math.IsInf(sum, 0) {
+		if math.IsInf(a, 0) || math.IsInf(b, 0) {
+			return 0
+		}
+		// The exact sum overflowed and is finite
+		return -sum
+	}
+
+	bVirtual := sum - a
+	return (a - (sum - bVirtual)) + (b - bVirtual)
+}
+
+func addDown(a float64, b float64) float64 {
+	sum := a + b
+	return roundDown(sum, sumError(a, b, sum))
+}
+
+func addUp(a float64, b float64) float64 {
+	sum := a + b
+	return roundUp(sum, sumError(a, b, sum))
+}
+
+func mulDown(a float64, b float64) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	product := a * b
+	if math.Abs(product) < minExactError {
+		return underflowDown(product)
+	}
+
+	return roundDown(product, math.FMA(a, b, -product))
+}
+
+func mulUp(a float64, b float64) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	product := a * b
+	if math.Abs(product) < minExactError {
+		return underflowUp(product)
+	}
+
+	return roundUp(product, math.FMA(a, b, -product))
+}
+
+// quotientError returns the sign of the rounding error of a quotient from its remainder
+func quotientError(a float64, b float64, quotient float64) float64 {
+	remainder := math.FMA(-quotient, b, a)
+	if b < 0 {
+		return -remainder
+	}
+
+	return remainder
+}
+
+func divDown(a float64, b float64) float64 {
+	quotient := a / b
+	if a != 0 && math.Abs(a) < minExactError && !math.IsInf(b, 0) {
+		return underflowDown(quotient)
+	}
+
+	return roundDown(quotient, quotientError(a, b, quotient))
+}
+
+func divUp(a float64, b float64) float64 {
+	quotient := a / b
+	if a != 0 && math.Abs(a) < minExactError && !math.IsInf(b, 0) {
+		return underflowUp(quotient)
+	}
+
+	return roundUp(quotient, quotientError(a, b, quotient))
+}
+
+func sqrtDown(x float64) float64 {
+	root := math.Sqrt(x)
+	if x != 0 && x < minExactError {
+		return underflowDown(root)
+	}
+
+	return roundDown(root, math.FMA(-root, root, x))
+}
+
+func sqrtUp(x float64) float64 {
+	root := math.Sqrt(x)
+	if x != 0 && x < minExactError {
+		return underflowUp(root)
+	}
+
+	return roundUp(root, math.FMA(-root, root, x))
+}
+
+// powDown returns a lower bound of a non-negative value raised to the power of n by
+// squaring, which stays a lower bound since every rounded product is non-negative
+func powDown(x float64, n int) float64 {
+	result := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = mulDown(result, x)
+		}
+		x = mulDown(x, x)
+	}
+
+	return result
+}
+
+func powUp(x float64, n int) float64 {
+	result := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = mulUp(result, x)
+		}
+		x = mulUp(x, x)
+	}
+
+	return result
+}
+
+// signedPowDown returns a lower bound of any value raised to an odd power n
+func signedPowDown(x float64, n int) float64 {
+	if x < 0 {
+		return -powUp(-x, n)
+	}
+
+	return powDown(x, n)
+}
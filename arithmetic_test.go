@@ -0,0 +1,157 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+// ARITHMETIC:
+// Rounds inexact results outward and keeps exact results
+func TestIntervalAddSub(t *testing.T) {
+	// The exact sum of the doubles nearest 0.1 and 0.2 lies between 0.3 and the next double
+	sum := Range{Start: 0.1, End: 0.1}.Add(Range{Start: 0.2, End: 0.2})
+	expected := Range{Start: 0.3, End: math.Nextafter(0.3, math.Inf(1))}
+	if !sum.Equal(expected) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expected, sum)
+	}
+
+	tests := []struct {
+		result   Range
+		expected Range
+	}{
+		{Range{Start: 1, End: 2}.Add(Range{Start: 3, End: 4}), Range{Start: 4, End: 6}},
+		{Range{Start: 1, End: 2}.Sub(Range{Start: 3, End: 4}), Range{Start: -3, End: -1}},
+		{Range{Start: math.Inf(-1), End: 2}.Add(Range{Start: 3, End: 4}), Range{Start: math.Inf(-1), End: 6}},
+		{Range{Start: math.MaxFloat64, End: math.MaxFloat64}.Add(Range{Start: math.MaxFloat64, End: math.MaxFloat64}), Range{Start: math.MaxFloat64, End: math.Inf(1)}},
+	}
+
+	for _, test := range tests {
+		if !test.result.Equal(test.expected) {
+			t.Errorf("Failed! Expected: %v, Got: %v", test.expected, test.result)
+		}
+	}
+}
+
+// Multiplies intervals
+func TestIntervalMul(t *testing.T) {
+	tests := []struct {
+		result   Range
+		expected Range
+	}{
+		{Range{Start: -2, End: 3}.Mul(Range{Start: -4, End: 5}), Range{Start: -12, End: 15}},
+		{Range{Start: 0, End: 1}.Mul(Range{Start: 2, End: math.Inf(1)}), Range{Start: 0, End: math.Inf(1)}},
+		{Range{Start: 0.1, End: 0.1}.Mul(Range{Start: 3, End: 3}), Range{Start: 0.3, End: math.Nextafter(0.3, math.Inf(1))}},
+	}
+
+	for _, test := range tests {
+		if !test.result.Equal(test.expected) {
+			t.Errorf("Failed! Expected: %v, Got: %v", test.expected, test.result)
+		}
+	}
+}
+
+// Divides intervals, splitting the quotient when the divisor contains zero
+func TestIntervalDiv(t *testing.T) {
+	tests := []struct {
+		dividend Range
+		divisor  Range
+		expected RangeCollection
+	}{
+		{Range{Start: 1, End: 2}, Range{Start: 4, End: 8}, RangeCollection{Range{Start: 0.125, End: 0.5}}},
+		{Range{Start: 1, End: 2}, Range{Start: -1, End: 2}, RangeCollection{Range{Start: math.Inf(-1), End: -1}, Range{Start: 0.5, End: math.Inf(1)}}},
+		{Range{Start: -2, End: -1}, Range{Start: 0, End: 4}, RangeCollection{Range{Start: math.Inf(-1), End: -0.25}}},
+		{Range{Start: -1, End: 1}, Range{Start: -1, End: 1}, RangeCollection{Range{Start: math.Inf(-1), End: math.Inf(1)}}},
+		{Range{Start: 1, End: math.Inf(1)}, Range{Start: 1, End: math.Inf(1)}, RangeCollection{Range{Start: 0, End: math.Inf(1)}}},
+	}
+
+	for _, test := range tests {
+		if quotient, err := test.dividend.Div(test.divisor); err != nil || !quotient.Equal(test.expected) {
+			t.Errorf("Failed! %v / %v Expected: %v, Got: %v, Error: %v", test.dividend, test.divisor, test.expected, quotient, err)
+		}
+	}
+
+	third, _ := Range{Start: 1, End: 1}.Div(Range{Start: 3, End: 3})
+	if third[0].Start >= third[0].End || !third[0].Contains(1.0/3) {
+		t.Errorf("Failed! Expected an interval around 1/3, Got: %v", third)
+	}
+
+	if _, err := (Range{Start: 1, End: 2}).Div(Range{Start: 0, End: 0}); err == nil {
+		t.Errorf("Failed! Division by zero should be rejected")
+	}
+}
+
+// Computes powers, roots, absolute values, minimums and maximums
+func TestIntervalFunctions(t *testing.T) {
+	tests := []struct {
+		result   Range
+		expected Range
+	}{
+		{mustRange(Range{Start: -3, End: 2}.Pow(2)), Range{Start: 0, End: 9}},
+		{mustRange(Range{Start: -3, End: 2}.Pow(3)), Range{Start: -27, End: 8}},
+		{mustRange(Range{Start: -3, End: -2}.Pow(2)), Range{Start: 4, End: 9}},
+		{mustRange(Range{Start: -3, End: 2}.Pow(0)), Range{Start: 1, End: 1}},
+		{mustRange(Range{Start: -4, End: 9}.Sqrt()), Range{Start: 0, End: 3}},
+		{Range{Start: -3, End: 2}.Abs(), Range{Start: 0, End: 3}},
+		{Range{Start: -3, End: -2}.Abs(), Range{Start: 2, End: 3}},
+		{Range{Start: 1, End: 5}.Min(Range{Start: 2, End: 3}), Range{Start: 1, End: 3}},
+		{Range{Start: 1, End: 5}.Max(Range{Start: 2, End: 3}), Range{Start: 2, End: 5}},
+	}
+
+	for _, test := range tests {
+		if !test.result.Equal(test.expected) {
+			t.Errorf("Failed! Expected: %v, Got: %v", test.expected, test.result)
+		}
+	}
+
+	root, _ := Range{Start: 2, End: 2}.Sqrt()
+	if root.Start >= root.End || root.Start > math.Sqrt2 || root.End < math.Sqrt2 {
+		t.Errorf("Failed! Expected an interval around the square root of 2, Got: %v", root)
+	}
+
+	if _, err := (Range{Start: -2, End: -1}).Sqrt(); err == nil {
+		t.Errorf("Failed! Square root of negative interval should be rejected")
+	}
+
+	if _, err := (Range{Start: 1, End: 2}).Pow(-1); err == nil {
+		t.Errorf("Failed! Negative power should be rejected")
+	}
+}
+
+// Rounds results that underflow outward
+func TestIntervalUnderflow(t *testing.T) {
+	tiny := Range{Start: 1e-200, End: 1e-200}
+	tests := []struct {
+		result   Range
+		expected Range
+	}{
+		{tiny.Mul(tiny), Range{Start: 0, End: math.SmallestNonzeroFloat64}},
+		{tiny.Mul(Range{Start: -1e-200, End: -1e-200}), Range{Start: -math.SmallestNonzeroFloat64, End: 0}},
+	}
+
+	for _, test := range tests {
+		if !test.result.Equal(test.expected) {
+			t.Errorf("Failed! Expected: %v, Got: %v", test.expected, test.result)
+		}
+	}
+
+	for _, n := range []int{2, 3} {
+		if power := mustRange(tiny.Pow(n)); power.Start != 0 || power.End <= 0 {
+			t.Errorf("Failed! Expected an interval above zero, Got: %v", power)
+		}
+	}
+
+	// The exact quotient is just above three times the smallest value
+	dividend := 3 * math.SmallestNonzeroFloat64
+	quotient, _ := Range{Start: dividend, End: dividend}.Div(Range{Start: 1 - 0x1p-53, End: 1 - 0x1p-53})
+	if quotient[0].Start > dividend || quotient[0].End <= dividend {
+		t.Errorf("Failed! Expected an interval above %v, Got: %v", dividend, quotient)
+	}
+}
+
+func mustRange(grange Range, err error) Range {
+	if err != nil {
+		panic(err)
+	}
+
+	return grange
+}
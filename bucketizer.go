@@ -0,0 +1,193 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Bucketizer assigns values to non-overlapping Ranges, or buckets, with a binary search
+// and counts the values that fall in each bucket, like a histogram. Values that fall
+// outside every bucket are kept as outliers.
+type Bucketizer struct {
+	buckets  RangeCollection
+	counts   []int
+	outliers []float64
+}
+
+// NewBucketizer creates a Bucketizer from non-overlapping buckets, which are sorted by
+// Start. It will return an error if any buckets overlap or start after they end.
+func NewBucketizer(buckets RangeCollection) (*Bucketizer, error) {
+	sorted := NewRangeCollection(buckets)
+	sort.Sort(sorted)
+
+	for _, bucket := range sorted {
+		if !(bucket.Start <= bucket.End) {
+			return nil, errors.New(fmt.Sprintf("Bucket %v starts after its end", bucket))
+		}
+	}
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Overlap(sorted[i]) {
+			return nil, errors.New(fmt.Sprintf("Bucket %v overlaps bucket %v", sorted[i-1], sorted[i]))
+		}
+	}
+
+	return &Bucketizer{buckets: sorted, counts: make([]int, len(sorted)), outliers: []float64{}}, nil
+}
+
+// EqualWidthBuckets creates a Bucketizer that divides a finite Range into n buckets of
+// equal width
+func EqualWidthBuckets(bounds Range, n int) (*Bucketizer, error) {
+	if err := checkBucketBounds(bounds, n); err != nil {
+		return nil, err
+	}
+
+	width := (bounds.End - bounds.Start) / float64(n)
+	edges := []float64{bounds.Start}
+	for i := 1; i < n; i++ {
+		edges = append(edges, bounds.Start+float64(i)*width)
+	}
+
+	buckets, err := bucketsFromEdges(append(edges, bounds.End))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBucketizer(buckets)
+}
+
+// LogBuckets creates a Bucketizer that divides a finite, positive Range into n buckets
+// whose edges are evenly spaced on a logarithmic scale
+func LogBuckets(bounds Range, n int) (*Bucketizer, error) {
+	if err := checkBucketBounds(bounds, n); err != nil {
+		return nil, err
+	} else if bounds.Start <= 0 {
+		return nil, errors.New(fmt.Sprintf("Range %v is not positive", bounds))
+	}
+
+	ratio := math.Log(bounds.End / bounds.Start)
+	edges := []float64{bounds.Start}
+	for i := 1; i < n; i++ {
+		edges = append(edges, bounds.Start*math.Exp(ratio*float64(i)/float64(n)))
+	}
+
+	buckets, err := bucketsFromEdges(append(edges, bounds.End))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBucketizer(buckets)
+}
+
+// QuantileBuckets creates a Bucketizer with up to n buckets that each hold roughly the
+// same number of the sample values. Buckets span from the smallest to the largest sample
+// value, and fewer than n buckets are created when sample values repeat.
+func QuantileBuckets(sample []float64, n int) (*Bucketizer, error) {
+	if len(sample) == 0 {
+		return nil, errors.New("Sample is empty")
+	} else if n <= 0 {
+		return nil, errors.New(fmt.Sprintf("Invalid bucket count: %d", n))
+	}
+
+	sorted := append([]float64{}, sample...)
+	sort.Float64s(sorted)
+
+	edges := []float64{sorted[0]}
+	for i := 1; i < n; i++ {
+		if edge := sorted[i*len(sorted)/n]; edge > edges[len(edges)-1] {
+			edges = append(edges, edge)
+		}
+	}
+
+	if last := sorted[len(sorted)-1]; last > edges[len(edges)-1] || len(edges) == 1 {
+		edges = append(edges, last)
+	}
+
+	buckets, err := bucketsFromEdges(edges)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBucketizer(buckets)
+}
+
+// Buckets returns the buckets of the Bucketizer in order
+func (b *Bucketizer) Buckets() RangeCollection {
+	return NewRangeCollection(b.buckets)
+}
+
+// Bucket returns the index of the bucket containing a value, or false if no bucket
+// contains it
+func (b *Bucketizer) Bucket(float float64) (int, bool) {
+	index := sort.Search(len(b.buckets), func(i int) bool {
+		return b.buckets[i].End >= float
+	})
+
+	if index < len(b.buckets) && b.buckets[index].Contains(float) {
+		return index, true
+	}
+
+	return -1, false
+}
+
+// Add counts values in their buckets, keeping values outside every bucket as outliers
+func (b *Bucketizer) Add(values ...float64) {
+	for _, value := range values {
+		if index, ok := b.Bucket(value); ok {
+			b.counts[index]++
+		} else {
+			b.outliers = append(b.outliers, value)
+		}
+	}
+}
+
+// Counts returns the number of values counted in each bucket
+func (b *Bucketizer) Counts() []int {
+	return append([]int{}, b.counts...)
+}
+
+// Outliers returns the values that fell outside every bucket, in the order they were added
+func (b *Bucketizer) Outliers() []float64 {
+	return append([]float64{}, b.outliers...)
+}
+
+// Reset clears the counts and outliers of the Bucketizer
+func (b *Bucketizer) Reset() {
+	b.counts = make([]int, len(b.buckets))
+	b.outliers = []float64{}
+}
+
+func checkBucketBounds(bounds Range, n int) error {
+	if n <= 0 {
+		return errors.New(fmt.Sprintf("Invalid bucket count: %d", n))
+	} else if math.IsInf(bounds.Start, 0) || math.IsInf(bounds.End, 0) {
+		return errors.New(fmt.Sprintf("Range %v is unbounded", bounds))
+	} else if bounds.Start >= bounds.End {
+		return errors.New(fmt.Sprintf("Range %v has no width", bounds))
+	}
+
+	return nil
+}
+
+// bucketsFromEdges creates buckets between increasing edges. Each bucket ends just before
+// the next edge, except the last, which includes the final edge. It will return an error
+// if the edges are not strictly increasing, which happens when a Range is too narrow to
+// be divided into distinct buckets, unless they form a single bucket of one value.
+func bucketsFromEdges(edges []float64) (RangeCollection, error) {
+	buckets := RangeCollection{}
+	for i := 0; i < len(edges)-1; i++ {
+		if !(edges[i] < edges[i+1]) && !(len(edges) == 2 && edges[i] == edges[i+1]) {
+			return nil, errors.New(fmt.Sprintf("Bucket edges %v and %v are not increasing", edges[i], edges[i+1]))
+		}
+
+		end := math.Nextafter(edges[i+1], math.Inf(-1))
+		if i == len(edges)-2 {
+			end = edges[i+1]
+		}
+		buckets = append(buckets, Range{Start: edges[i], End: end})
+	}
+
+	return buckets, nil
+}
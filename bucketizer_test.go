@@ -0,0 +1,109 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+// BUCKETING:
+// Counts values in buckets and keeps outliers
+func TestBucketizer(t *testing.T) {
+	bucketizer, err := NewBucketizer(RangeCollection{Range{Start: 10, End: 20}, Range{Start: 0, End: 5}, Range{Start: 30, End: math.Inf(1)}})
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	bucketizer.Add(0, 5, 7, 10, 15, 20, 25, 100, -1)
+
+	expectedCounts := []int{2, 3, 1}
+	for i, count := range bucketizer.Counts() {
+		if count != expectedCounts[i] {
+			t.Errorf("Failed! Expected: %v, Got: %v", expectedCounts, bucketizer.Counts())
+		}
+	}
+
+	expectedOutliers := []float64{7, 25, -1}
+	for i, outlier := range bucketizer.Outliers() {
+		if outlier != expectedOutliers[i] {
+			t.Errorf("Failed! Expected: %v, Got: %v", expectedOutliers, bucketizer.Outliers())
+		}
+	}
+
+	bucketizer.Reset()
+	if bucketizer.Counts()[0] != 0 || len(bucketizer.Outliers()) != 0 {
+		t.Errorf("Failed! Reset did not clear %v", bucketizer.Counts())
+	}
+
+	if _, err := NewBucketizer(RangeCollection{Range{Start: 0, End: 5}, Range{Start: 5, End: 10}}); err == nil {
+		t.Errorf("Failed! Overlapping buckets should be rejected")
+	}
+}
+
+// Generates equal width and log scale buckets
+func TestGeneratedBuckets(t *testing.T) {
+	equal, err := EqualWidthBuckets(Range{Start: 0, End: 10}, 4)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	expected := RangeCollection{
+		Range{Start: 0, End: math.Nextafter(2.5, math.Inf(-1))},
+		Range{Start: 2.5, End: math.Nextafter(5, math.Inf(-1))},
+		Range{Start: 5, End: math.Nextafter(7.5, math.Inf(-1))},
+		Range{Start: 7.5, End: 10},
+	}
+	if !equal.Buckets().Equal(expected) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expected, equal.Buckets())
+	}
+
+	logarithmic, err := LogBuckets(Range{Start: 1, End: 1000}, 3)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	for value, expected := range map[float64]int{1: 0, 9.99: 0, 10.01: 1, 99.9: 1, 100.1: 2, 1000: 2} {
+		if index, ok := logarithmic.Bucket(value); !ok || index != expected {
+			t.Errorf("Failed! Expected %v in bucket %v, Got: %v", value, expected, index)
+		}
+	}
+
+	for _, bounds := range []Range{{Start: 0, End: math.Inf(1)}, {Start: 5, End: 5}} {
+		if _, err := EqualWidthBuckets(bounds, 2); err == nil {
+			t.Errorf("Failed! Bounds %v should be rejected", bounds)
+		}
+	}
+
+	if _, err := LogBuckets(Range{Start: 0, End: 10}, 2); err == nil {
+		t.Errorf("Failed! Non-positive bounds should be rejected")
+	}
+	// Too narrow for distinct edges
+	if bucketizer, err := EqualWidthBuckets(Range{Start: 1, End: 1.0000000000000004}, 8); err == nil {
+		t.Errorf("Failed! Expected failure with: %v", bucketizer.Buckets())
+	}
+
+	if _, err := NewBucketizer(RangeCollection{Range{Start: 1, End: 0.5}}); err == nil {
+		t.Errorf("Failed! Inverted bucket should be rejected")
+	}
+}
+
+// Generates quantile buckets from a sample
+func TestQuantileBuckets(t *testing.T) {
+	sample := []float64{9, 1, 2, 3, 4, 5, 6, 7, 8, 10, 11, 12}
+
+	bucketizer, err := QuantileBuckets(sample, 3)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+	bucketizer.Add(sample...)
+
+	for i, count := range bucketizer.Counts() {
+		if count != 4 {
+			t.Errorf("Failed! Expected 4 values in bucket %d, Got: %v", i, bucketizer.Counts())
+		}
+	}
+
+	repeated, _ := QuantileBuckets([]float64{3, 3, 3}, 2)
+	if !repeated.Buckets().Equal(RangeCollection{Range{Start: 3, End: 3}}) {
+		t.Errorf("Failed! Expected a single bucket, Got: %v", repeated.Buckets())
+	}
+}
@@ -0,0 +1,403 @@
+// Command gorange parses, merges and expands Python-like ranges from the command line.
+//
+// Usage:
+//
+//	gorange <command> [flags] [range sets...]
+//
+// Each range set is a comma separated list of ranges such as "1:5,10:". When no range
+// sets are given as arguments they are read from standard input, separated by
+// whitespace. Flags end at the first range set, so negative ranges such as "-5:3" are
+// not mistaken for flags; "--" also ends the flags. The commands are:
+//
+//	merge       print the union of all range sets
+//	expand      print every value in the union of all range sets, like seq
+//	contains    test if the union of all range sets contains a value
+//	intersect   print the values shared by all range sets
+//	complement  print the values not in the union of all range sets
+//	format      print each range in another syntax
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tkmcclellan/gorange"
+)
+
+// errNotContained is returned by contains when the value is not in the range sets, so
+// that the command exits with a non-zero status without printing an error
+var errNotContained = errors.New("value is not contained")
+
+// errInvalidFlags is returned when the flags of a command cannot be parsed, after the
+// flag package has printed the error along with the usage of the command
+var errInvalidFlags = errors.New("invalid flags")
+
+const usage = `usage: gorange <command> [flags] [range sets...]
+
+commands:
+  merge       print the union of all range sets
+  expand      print every value in the union of all range sets, like seq
+  contains    test if the union of all range sets contains a value
+  intersect   print the values shared by all range sets
+  complement  print the values not in the union of all range sets
+  format      print each range in another syntax
+
+Range sets are comma separated ranges such as "1:5,10:", read from standard input
+when none are given. Flags end at the first range set, so negative ranges such as
+"-5:3" may be given directly; "--" also ends the flags. Run "gorange <command> -h"
+for the flags of a command.
+`
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	if errors.Is(err, errNotContained) {
+		os.Exit(1)
+	} else if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	} else if errors.Is(err, errInvalidFlags) {
+		os.Exit(2)
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "gorange: %v\n", err)
+		os.Exit(2)
+	}
+}
+
+type options struct {
+	delimiter string
+	output    string
+	to        string
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return errors.New("missing command")
+	}
+
+	command := args[0]
+	commands := map[string]func(options, []string, io.Writer) error{
+		"merge":      merge,
+		"expand":     expand,
+		"contains":   contains,
+		"intersect":  intersect,
+		"complement": complement,
+		"format":     format,
+	}
+
+	fn, ok := commands[command]
+	if !ok {
+		if command == "-h" || command == "--help" || command == "help" {
+			fmt.Fprint(stdout, usage)
+			return nil
+		}
+		fmt.Fprint(stderr, usage)
+		return errors.New(fmt.Sprintf("unknown command %q", command))
+	}
+
+	opts := options{}
+	flags := flag.NewFlagSet("gorange "+command, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&opts.delimiter, "d", ":", "delimiter between the bounds of a range")
+	flags.StringVar(&opts.output, "o", "plain", "output format: plain, lines or json")
+	if command == "format" {
+		flags.StringVar(&opts.to, "to", "range", "output syntax: range, expression, postgres, rust, ruby, kotlin or swift")
+	}
+
+	count := flagCount(flags, args[1:])
+	if err := flags.Parse(args[1 : count+1]); errors.Is(err, flag.ErrHelp) {
+		return err
+	} else if err != nil {
+		return errInvalidFlags
+	}
+
+	if opts.output != "plain" && opts.output != "lines" && opts.output != "json" {
+		return errors.New(fmt.Sprintf("unknown output format %q", opts.output))
+	}
+
+	operands, value := append(flags.Args(), args[count+1:]...), ""
+	if command == "contains" {
+		if len(operands) == 0 {
+			return errors.New("contains requires a value")
+		}
+		value, operands = operands[0], operands[1:]
+	}
+
+	if len(operands) == 0 {
+		var err error
+		operands, err = readOperands(stdin)
+		if err != nil {
+			return err
+		}
+	}
+
+	if command == "contains" {
+		operands = append([]string{value}, operands...)
+	}
+
+	return fn(opts, operands, stdout)
+}
+
+// flagCount returns the number of leading arguments that are flags or flag values.
+// Negative ranges such as -5:3 look like flags, so the flags end at the first argument
+// that is not a defined flag and parses as a range set.
+func flagCount(flags *flag.FlagSet, args []string) int {
+	delimiter := flags.Lookup("d").DefValue
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return i + 1
+		} else if len(arg) < 2 || arg[0] != '-' {
+			return i
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if flags.Lookup(name) == nil {
+			if _, err := parseSets(options{delimiter: delimiter}, []string{arg}); err == nil {
+				return i
+			}
+			// Leave unknown flags for the flag package to report
+			continue
+		}
+
+		if !hasValue && i+1 < len(args) {
+			i++
+			value = args[i]
+		}
+		if name == "d" {
+			delimiter = value
+		}
+	}
+
+	return len(args)
+}
+
+func readOperands(stdin io.Reader) ([]string, error) {
+	operands := []string{}
+	scanner := bufio.NewScanner(stdin)
+	scanner.Split(bufio.ScanWords)
+
+	for scanner.Scan() {
+		operands = append(operands, scanner.Text())
+	}
+
+	return operands, scanner.Err()
+}
+
+func parseSets(opts options, operands []string) ([]gorange.RangeCollection, error) {
+	sets := []gorange.RangeCollection{}
+	parseOptions := gorange.ParseOptions{Delimiters: []string{opts.delimiter}, TrimSpace: true}
+
+	for _, operand := range operands {
+		collection, err := gorange.ParseRangeCollectionWithOptions(strings.Split(operand, ","), parseOptions)
+		if err != nil {
+			return sets, err
+		}
+		sets = append(sets, collection)
+	}
+
+	return sets, nil
+}
+
+func union(opts options, operands []string) (gorange.RangeCollection, error) {
+	sets, err := parseSets(opts, operands)
+	if err != nil {
+		return gorange.RangeCollection{}, err
+	}
+
+	collection := gorange.RangeCollection{}
+	for _, set := range sets {
+		collection = collection.Union(set)
+	}
+
+	return collection, nil
+}
+
+func merge(opts options, operands []string, stdout io.Writer) error {
+	collection, err := union(opts, operands)
+	if err != nil {
+		return err
+	}
+
+	return writeRanges(opts, collection, stdout)
+}
+
+func intersect(opts options, operands []string, stdout io.Writer) error {
+	sets, err := parseSets(opts, operands)
+	if err != nil {
+		return err
+	}
+
+	collection := gorange.RangeCollection{}
+	for i, set := range sets {
+		if i == 0 {
+			collection = set.Merge()
+		} else {
+			collection = collection.Intersect(set)
+		}
+	}
+
+	return writeRanges(opts, collection, stdout)
+}
+
+func complement(opts options, operands []string, stdout io.Writer) error {
+	collection, err := union(opts, operands)
+	if err != nil {
+		return err
+	}
+
+	return writeRanges(opts, collection.Complement(), stdout)
+}
+
+func expand(opts options, operands []string, stdout io.Writer) error {
+	collection, err := union(opts, operands)
+	if err != nil {
+		return err
+	}
+
+	for _, grange := range collection {
+		if math.IsInf(grange.Start, 0) || math.IsInf(grange.End, 0) {
+			return errors.New(fmt.Sprintf("cannot expand unbounded range %s", grange.Format(opts.delimiter)))
+		}
+	}
+
+	values := collection.Values()
+	if opts.output == "json" {
+		return writeJSON(values, stdout)
+	}
+
+	svalues := []string{}
+	for _, value := range values {
+		svalues = append(svalues, gorange.FormatFloat(value))
+	}
+
+	return writeStrings(opts, svalues, " ", stdout)
+}
+
+func contains(opts options, operands []string, stdout io.Writer) error {
+	value, err := strconv.ParseFloat(operands[0], 64)
+	if err != nil {
+		return errors.New(fmt.Sprintf("invalid value %q", operands[0]))
+	}
+
+	collection, err := union(opts, operands[1:])
+	if err != nil {
+		return err
+	}
+
+	contained := collection.Contains(value)
+	if opts.output == "json" {
+		err = writeJSON(contained, stdout)
+	} else {
+		_, err = fmt.Fprintln(stdout, contained)
+	}
+
+	if err == nil && !contained {
+		return errNotContained
+	}
+
+	return err
+}
+
+func format(opts options, operands []string, stdout io.Writer) error {
+	sets, err := parseSets(opts, operands)
+	if err != nil {
+		return err
+	}
+
+	collection := gorange.RangeCollection{}
+	for _, set := range sets {
+		collection = append(collection, set...)
+	}
+
+	formatted := []string{}
+	switch opts.to {
+	case "range":
+		formatted = collection.Format(opts.delimiter)
+	case "expression":
+		formatted = []string{gorange.FormatRangeExpression(collection, opts.delimiter)}
+	case "postgres":
+		for _, grange := range collection {
+			formatted = append(formatted, gorange.FormatPostgresRange(grange, gorange.FormatFloat))
+		}
+	default:
+		dialects := map[string]gorange.RangeDialect{
+			"rust":   gorange.RustDialect,
+			"ruby":   gorange.RubyDialect,
+			"kotlin": gorange.KotlinDialect,
+			"swift":  gorange.SwiftDialect,
+		}
+
+		dialect, ok := dialects[opts.to]
+		if !ok {
+			return errors.New(fmt.Sprintf("unknown syntax %q", opts.to))
+		}
+
+		for _, grange := range collection {
+			literal, err := grange.FormatDialect(dialect)
+			if err != nil {
+				return err
+			}
+			formatted = append(formatted, literal)
+		}
+	}
+
+	if opts.output == "json" {
+		return writeJSON(formatted, stdout)
+	}
+
+	return writeStrings(opts, formatted, " ", stdout)
+}
+
+// jsonRange is the JSON form of a Range, with unbounded ends as null since JSON cannot
+// represent infinity
+type jsonRange struct {
+	Start *float64 `json:"start"`
+	End   *float64 `json:"end"`
+}
+
+func writeRanges(opts options, collection gorange.RangeCollection, stdout io.Writer) error {
+	if opts.output == "json" {
+		ranges := []jsonRange{}
+		for _, grange := range collection {
+			start, end := grange.Start, grange.End
+			jrange := jsonRange{}
+			if !math.IsInf(start, 0) {
+				jrange.Start = &start
+			}
+			if !math.IsInf(end, 0) {
+				jrange.End = &end
+			}
+			ranges = append(ranges, jrange)
+		}
+
+		return writeJSON(ranges, stdout)
+	}
+
+	return writeStrings(opts, collection.Format(opts.delimiter), ",", stdout)
+}
+
+func writeStrings(opts options, values []string, separator string, stdout io.Writer) error {
+	if opts.output == "lines" {
+		separator = "\n"
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	_, err := fmt.Fprintln(stdout, strings.Join(values, separator))
+	return err
+}
+
+func writeJSON(value interface{}, stdout io.Writer) error {
+	return json.NewEncoder(stdout).Encode(value)
+}
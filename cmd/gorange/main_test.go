@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func runTest(t *testing.T, args []string, stdin string, expectedOutput string) {
+	stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+	err := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	if err != nil || stdout.String() != expectedOutput {
+		t.Errorf("Failed! Args: %v, Expected: %q, Got: %q, Error: %v", args, expectedOutput, stdout.String(), err)
+	}
+}
+
+func runFailureTest(t *testing.T, args []string, stdin string) {
+	stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+	err := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	if err == nil {
+		t.Errorf("Failed! Args: %v, Expected failure with: %q", args, stdout.String())
+	}
+}
+
+// MERGING:
+// Merges ranges from arguments
+func TestMergeCommand(t *testing.T) {
+	runTest(t, []string{"merge", "5:7,1:2", "6:10"}, "", "1:2,5:10\n")
+	runTest(t, []string{"merge", "-o", "lines", "5:7,1:2", "6:"}, "", "1:2\n5:\n")
+	runTest(t, []string{"merge", "-o", "json", "5:7", ":2"}, "", `[{"start":null,"end":2},{"start":5,"end":7}]`+"\n")
+	runTest(t, []string{"merge", "-d", "-", "1-3", "2-4"}, "", "1-4\n")
+}
+
+// Merges negative ranges that look like flags
+func TestMergeCommandNegative(t *testing.T) {
+	runTest(t, []string{"merge", "-5:3"}, "", "-5:3\n")
+	runTest(t, []string{"merge", "-o", "lines", "-10:-8", "-2"}, "", "-10:-8\n-2\n")
+	runTest(t, []string{"merge", "-d", "..", "-o=lines", "-3..-1"}, "", "-3..-1\n")
+	runTest(t, []string{"merge", "--", "-1:0"}, "", "-1:0\n")
+
+	stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+	if err := run([]string{"merge", "-x", "1:2"}, strings.NewReader(""), &stdout, &stderr); !errors.Is(err, errInvalidFlags) || stderr.Len() == 0 {
+		t.Errorf("Failed! Expected the flag package to report -x, Got: %q, Error: %v", stderr.String(), err)
+	}
+}
+
+// Merges ranges from standard input
+func TestMergeCommandStdin(t *testing.T) {
+	runTest(t, []string{"merge"}, "1:3\n2:4 10\n", "1:4,10\n")
+}
+
+// EXPANDING:
+// Expands ranges into values
+func TestExpandCommand(t *testing.T) {
+	runTest(t, []string{"expand", "1:3,5"}, "", "1 2 3 5\n")
+	runTest(t, []string{"expand", "-o", "lines", "3:4"}, "", "3\n4\n")
+	runTest(t, []string{"expand", "-o", "json", "1:2"}, "", "[1,2]\n")
+}
+
+// Fails to expand invalid and unbounded ranges
+func TestExpandCommandFailure(t *testing.T) {
+	runFailureTest(t, []string{"expand", "1:"}, "")
+	runFailureTest(t, []string{"expand", "3:1"}, "")
+	runFailureTest(t, []string{"expand", "-o", "xml", "1:2"}, "")
+}
+
+// CONTAINING:
+// Tests if ranges contain a value
+func TestContainsCommand(t *testing.T) {
+	runTest(t, []string{"contains", "5", "1:3", "4:6"}, "", "true\n")
+	runTest(t, []string{"contains", "-o", "json", "5"}, "4:", "true\n")
+	runTest(t, []string{"contains", "-2", "-3:1"}, "", "true\n")
+
+	stdout := bytes.Buffer{}
+	err := run([]string{"contains", "3.5", "1:3", "4:6"}, strings.NewReader(""), &stdout, &bytes.Buffer{})
+	if !errors.Is(err, errNotContained) || stdout.String() != "false\n" {
+		t.Errorf("Failed! Expected: false, Got: %q, Error: %v", stdout.String(), err)
+	}
+
+	runFailureTest(t, []string{"contains"}, "")
+	runFailureTest(t, []string{"contains", "x", "1:2"}, "")
+}
+
+// SET OPERATIONS:
+// Intersects range sets
+func TestIntersectCommand(t *testing.T) {
+	runTest(t, []string{"intersect", "0:10,20:", "5:25", ":22"}, "", "5:10,20:22\n")
+}
+
+// Complements range sets
+func TestComplementCommand(t *testing.T) {
+	runTest(t, []string{"complement", "5:", ":-5"}, "", "-4.999999999999999:4.999999999999999\n")
+	runTest(t, []string{"complement", ":"}, "", "")
+}
+
+// FORMATTING:
+// Formats ranges in other syntaxes
+func TestFormatCommand(t *testing.T) {
+	runTest(t, []string{"format", "-to", "rust", "1:5", ":3"}, "", "1..=5 ..=3\n")
+	runTest(t, []string{"format", "-to", "postgres", "-o", "lines", "1:5,7:"}, "", "[1,5]\n[7,)\n")
+	runTest(t, []string{"format", "-to", "expression", "3:4", "1:3"}, "", "1:4\n")
+	runTest(t, []string{"format", "-d", "-", "-o", "json", "1-5"}, "", `["1-5"]`+"\n")
+}
+
+// Fails to format ranges in unknown or unsupported syntaxes
+func TestFormatCommandFailure(t *testing.T) {
+	runFailureTest(t, []string{"format", "-to", "cobol", "1:5"}, "")
+	runFailureTest(t, []string{"format", "-to", "kotlin", "1:"}, "")
+}
+
+// Fails with unknown command
+func TestUnknownCommand(t *testing.T) {
+	runFailureTest(t, []string{}, "")
+	runFailureTest(t, []string{"sort", "1:2"}, "")
+}
@@ -0,0 +1,221 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronDomain describes the bounded set of integer values a cron field may take and the
+// names that may be used in place of those values
+type CronDomain struct {
+	Bounds Range
+	Names  map[string]int
+}
+
+var (
+	// CronMinutes is the domain of the minute field of a cron expression
+	CronMinutes = CronDomain{Bounds: Range{Start: 0, End: 59}}
+	// CronHours is the domain of the hour field of a cron expression
+	CronHours = CronDomain{Bounds: Range{Start: 0, End: 23}}
+	// CronDaysOfMonth is the domain of the day of month field of a cron expression
+	CronDaysOfMonth = CronDomain{Bounds: Range{Start: 1, End: 31}}
+	// CronMonths is the domain of the month field of a cron expression
+	CronMonths = CronDomain{Bounds: Range{Start: 1, End: 12}, Names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// CronDaysOfWeek is the domain of the day of week field of a cron expression. Both 0
+	// and 7 represent Sunday.
+	CronDaysOfWeek = CronDomain{Bounds: Range{Start: 0, End: 7}, Names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// ParseCronField parses a cron field into a merged RangeCollection of the values it
+// selects from the supplied domain. A field is a comma separated list of "*", "Num",
+// or "Num-Num" items, each optionally followed by a "/Step" suffix. Names from the
+// domain may be used in place of numbers.
+func ParseCronField(field string, domain CronDomain) (RangeCollection, error) {
+	collection := RangeCollection{}
+
+	for _, item := range strings.Split(field, ",") {
+		itemCollection, err := parseCronItem(item, domain)
+		if err != nil {
+			return RangeCollection{}, cronFieldError(field, err)
+		}
+		collection = append(collection, itemCollection...)
+	}
+
+	return collection.Merge(), nil
+}
+
+func parseCronItem(item string, domain CronDomain) (RangeCollection, error) {
+	base, sstep, stepped := strings.Cut(item, "/")
+
+	var grange Range
+	if base == "*" {
+		grange = domain.Bounds
+	} else {
+		sstart, send, isRange := strings.Cut(base, "-")
+
+		start, err := parseCronValue(sstart, domain)
+		if err != nil {
+			return RangeCollection{}, err
+		}
+
+		end := start
+		if isRange {
+			end, err = parseCronValue(send, domain)
+			if err != nil {
+				return RangeCollection{}, err
+			}
+		} else if stepped {
+			end = domain.Bounds.End
+		}
+
+		grange, err = NewRange(start, end)
+		if err != nil {
+			return RangeCollection{}, err
+		}
+	}
+
+	if !stepped {
+		return RangeCollection{grange}, nil
+	}
+
+	step, err := strconv.Atoi(sstep)
+	if err != nil || step <= 0 {
+		return RangeCollection{}, errors.New(fmt.Sprintf("Invalid step (%s)", sstep))
+	}
+
+	collection := RangeCollection{}
+	for value := grange.Start; value <= grange.End; value += float64(step) {
+		collection = append(collection, Range{Start: value, End: value})
+	}
+
+	return collection, nil
+}
+
+func parseCronValue(svalue string, domain CronDomain) (float64, error) {
+	value, ok := domain.Names[strings.ToUpper(svalue)]
+	if !ok {
+		var err error
+		value, err = strconv.Atoi(svalue)
+		if err != nil {
+			return 0, errors.New(fmt.Sprintf("Invalid value (%s)", svalue))
+		}
+	}
+
+	if !domain.Bounds.Contains(float64(value)) {
+		return 0, errors.New(fmt.Sprintf("Value (%s) is outside of %v", svalue, domain.Bounds))
+	}
+
+	return float64(value), nil
+}
+
+func cronFieldError(field string, err error) error {
+	return errors.New(fmt.Sprintf("Error parsing cron field (%s): %v", field, err))
+}
+
+// CronSchedule is a parsed five-field cron expression
+type CronSchedule struct {
+	Minutes     RangeCollection
+	Hours       RangeCollection
+	DaysOfMonth RangeCollection
+	Months      RangeCollection
+	DaysOfWeek  RangeCollection
+
+	// Following cron, when both day fields are restricted a day matches if either does
+	anyDay bool
+}
+
+// ParseCronSchedule parses a five-field cron expression in the form
+// "minute hour day-of-month month day-of-week"
+func ParseCronSchedule(expression string) (CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return CronSchedule{}, errors.New(fmt.Sprintf("Error parsing cron expression (%s): expected 5 fields, got %d", expression, len(fields)))
+	}
+
+	domains := []CronDomain{CronMinutes, CronHours, CronDaysOfMonth, CronMonths, CronDaysOfWeek}
+	collections := make([]RangeCollection, len(fields))
+
+	for i, field := range fields {
+		collection, err := ParseCronField(field, domains[i])
+		if err != nil {
+			return CronSchedule{}, err
+		}
+		collections[i] = collection
+	}
+
+	if collections[4].Contains(7) {
+		collections[4] = collections[4].Union(RangeCollection{Range{Start: 0, End: 0}})
+	}
+
+	return CronSchedule{
+		Minutes:     collections[0],
+		Hours:       collections[1],
+		DaysOfMonth: collections[2],
+		Months:      collections[3],
+		DaysOfWeek:  collections[4],
+		anyDay:      !strings.HasPrefix(fields[2], "*") && !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func (schedule CronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := schedule.DaysOfMonth.Contains(float64(t.Day()))
+	dayOfWeek := schedule.DaysOfWeek.Contains(float64(t.Weekday()))
+
+	if schedule.anyDay {
+		return dayOfMonth || dayOfWeek
+	}
+
+	return dayOfMonth && dayOfWeek
+}
+
+// Next returns the first time after t matched by the schedule. It will return an error
+// if the schedule does not match any time within five years of t.
+func (schedule CronSchedule) Next(t time.Time) (time.Time, error) {
+	location := t.Location()
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(5, 0, 0)
+
+	for next.Before(limit) {
+		year, month, day := next.Date()
+
+		if !schedule.Months.Contains(float64(month)) {
+			next = time.Date(year, month+1, 1, 0, 0, 0, 0, location)
+		} else if !schedule.matchesDay(next) {
+			next = time.Date(year, month, day+1, 0, 0, 0, 0, location)
+		} else if !schedule.Hours.Contains(float64(next.Hour())) {
+			// Advance by elapsed time, since the next wall clock hour may not exist when
+			// daylight saving time starts
+			next = next.Add(time.Duration(60-next.Minute()) * time.Minute)
+		} else if !schedule.Minutes.Contains(float64(next.Minute())) {
+			next = next.Add(time.Minute)
+		} else {
+			return next, nil
+		}
+	}
+
+	return t, errors.New(fmt.Sprintf("Cron schedule does not match any time within five years of %v", t))
+}
+
+// NextN returns the next n times after t matched by the schedule
+func (schedule CronSchedule) NextN(t time.Time, n int) ([]time.Time, error) {
+	times := []time.Time{}
+
+	for i := 0; i < n; i++ {
+		next, err := schedule.Next(t)
+		if err != nil {
+			return times, err
+		}
+		times = append(times, next)
+		t = next
+	}
+
+	return times, nil
+}
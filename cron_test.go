@@ -0,0 +1,159 @@
+package gorange
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func cronFieldTest(t *testing.T, field string, domain CronDomain, expectedCollection RangeCollection) {
+	collection, err := ParseCronField(field, domain)
+
+	if err != nil || !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Field: %s, Expected: %v, Got: %v, Error: %v", field, expectedCollection, collection, err)
+	}
+}
+
+// PARSING:
+// Parses wildcard field
+func TestParseWildcardCronField(t *testing.T) {
+	cronFieldTest(t, "*", CronHours, RangeCollection{Range{Start: 0, End: 23}})
+}
+
+// Parses stepped wildcard field
+func TestParseSteppedCronField(t *testing.T) {
+	expectedCollection := RangeCollection{Range{Start: 0, End: 0}, Range{Start: 15, End: 15}, Range{Start: 30, End: 30}, Range{Start: 45, End: 45}}
+	cronFieldTest(t, "*/15", CronMinutes, expectedCollection)
+	cronFieldTest(t, "0-59/15", CronMinutes, expectedCollection)
+	cronFieldTest(t, "10/20", CronMinutes, RangeCollection{Range{Start: 10, End: 10}, Range{Start: 30, End: 30}, Range{Start: 50, End: 50}})
+}
+
+// Parses range and list fields
+func TestParseListCronField(t *testing.T) {
+	cronFieldTest(t, "1-5", CronDaysOfWeek, RangeCollection{Range{Start: 1, End: 5}})
+	cronFieldTest(t, "1-3,2-6,9", CronDaysOfMonth, RangeCollection{Range{Start: 1, End: 6}, Range{Start: 9, End: 9}})
+}
+
+// Parses named field
+func TestParseNamedCronField(t *testing.T) {
+	cronFieldTest(t, "MON-FRI", CronDaysOfWeek, RangeCollection{Range{Start: 1, End: 5}})
+	cronFieldTest(t, "jan,MAR", CronMonths, RangeCollection{Range{Start: 1, End: 1}, Range{Start: 3, End: 3}})
+}
+
+// Fails to parse invalid fields
+func TestParseInvalidCronField(t *testing.T) {
+	fields := []string{"", "60", "5-1", "*/0", "*/x", "MON", "1-", "1,,2"}
+
+	for _, field := range fields {
+		collection, err := ParseCronField(field, CronMinutes)
+
+		if err == nil {
+			t.Errorf("Failed! Field: %s, Expected failure with: %v", field, collection)
+		}
+	}
+}
+
+// Fails to parse expression with wrong number of fields
+func TestParseInvalidCronSchedule(t *testing.T) {
+	schedule, err := ParseCronSchedule("* * * *")
+
+	if err == nil {
+		t.Errorf("Failed! Expected failure with: %v", schedule)
+	}
+}
+
+// SCHEDULING:
+func cronNextTest(t *testing.T, expression string, from time.Time, expectedTimes []time.Time) {
+	schedule, err := ParseCronSchedule(expression)
+	if err != nil {
+		t.Fatalf("Failed! Could not parse %s: %v", expression, err)
+	}
+
+	times, err := schedule.NextN(from, len(expectedTimes))
+	if err != nil || len(times) != len(expectedTimes) {
+		t.Fatalf("Failed! Expression: %s, Expected: %v, Got: %v, Error: %v", expression, expectedTimes, times, err)
+	}
+
+	for i := range times {
+		if !times[i].Equal(expectedTimes[i]) {
+			t.Errorf("Failed! Expression: %s, Expected: %v, Got: %v", expression, expectedTimes, times)
+		}
+	}
+}
+
+// Gets next times for stepped minutes
+func TestCronNextSteppedMinutes(t *testing.T) {
+	from := time.Date(2024, 1, 1, 10, 7, 30, 0, time.UTC)
+	cronNextTest(t, "*/15 * * * *", from, []time.Time{
+		time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+	})
+}
+
+// Gets next times for weekdays
+func TestCronNextWeekdays(t *testing.T) {
+	// 2024-01-05 is a Friday
+	from := time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC)
+	cronNextTest(t, "0 9 * * MON-FRI", from, []time.Time{
+		time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 9, 9, 0, 0, 0, time.UTC),
+	})
+}
+
+// Gets next times for named months across years
+func TestCronNextMonths(t *testing.T) {
+	from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	cronNextTest(t, "30 6 1 JAN,MAR *", from, []time.Time{
+		time.Date(2025, 1, 1, 6, 30, 0, 0, time.UTC),
+		time.Date(2025, 3, 1, 6, 30, 0, 0, time.UTC),
+	})
+}
+
+// Matches either day field when both are restricted
+func TestCronNextEitherDay(t *testing.T) {
+	// 2024-01-07 is a Sunday
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cronNextTest(t, "0 0 3 * 7", from, []time.Time{
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC),
+	})
+}
+
+// Fails to find next time for impossible schedule
+func TestCronNextImpossible(t *testing.T) {
+	schedule, _ := ParseCronSchedule("0 0 30 FEB *")
+	next, err := schedule.Next(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	if err == nil {
+		t.Errorf("Failed! Expected failure with: %v", next)
+	}
+}
+
+// Skips wall clock times that do not exist when daylight saving time starts
+func TestCronNextDaylightSaving(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	schedule, _ := ParseCronSchedule("30 2 * * *")
+	expected := []time.Time{
+		time.Date(2024, time.March, 11, 2, 30, 0, 0, newYork),
+		time.Date(2024, time.March, 12, 2, 30, 0, 0, newYork),
+	}
+
+	// 2:30 does not exist on 10 March 2024 in New York
+	times, err := schedule.NextN(time.Date(2024, time.March, 9, 12, 0, 0, 0, newYork), 2)
+	if err != nil || len(times) != 2 || !times[0].Equal(expected[0]) || !times[1].Equal(expected[1]) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", expected, times, err)
+	}
+
+	hourly, _ := ParseCronSchedule("15 * * * *")
+	expectedHourly := time.Date(2024, time.March, 10, 3, 15, 0, 0, newYork)
+	if next, err := hourly.Next(time.Date(2024, time.March, 10, 1, 20, 0, 0, newYork)); err != nil || !next.Equal(expectedHourly) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", expectedHourly, next, err)
+	}
+}
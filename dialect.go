@@ -0,0 +1,209 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// RangeDialect identifies the range literal syntax of a programming language
+type RangeDialect int
+
+const (
+	// RustDialect parses "a..b" as excluding b and "a..=b" as including b
+	RustDialect RangeDialect = iota
+	// RubyDialect parses "a..b" as including b and "a...b" as excluding b
+	RubyDialect
+	// KotlinDialect parses "a..b" as including b and "a..<b" or "a until b" as excluding b
+	KotlinDialect
+	// SwiftDialect parses "a...b" as including b and "a..<b" as excluding b
+	SwiftDialect
+)
+
+type dialectOperator struct {
+	operator  string
+	exclusive bool
+}
+
+type dialectSyntax struct {
+	name string
+	// Operators are ordered so that no operator is a prefix of a later one
+	operators []dialectOperator
+	// A literal that may be used in place of an unbounded side of a range
+	open string
+	// The operators that may be used with an unbounded start or end
+	openStart []string
+	openEnd   []string
+	// The literal for a range unbounded in both directions
+	infinite string
+}
+
+var dialectSyntaxes = map[RangeDialect]dialectSyntax{
+	RustDialect: {
+		name:      "Rust",
+		operators: []dialectOperator{{"..=", false}, {"..", true}},
+		openStart: []string{"..=", ".."},
+		openEnd:   []string{".."},
+		infinite:  "..",
+	},
+	RubyDialect: {
+		name:      "Ruby",
+		operators: []dialectOperator{{"...", true}, {"..", false}},
+		open:      "nil",
+		openStart: []string{"...", ".."},
+		openEnd:   []string{"...", ".."},
+		infinite:  "nil..nil",
+	},
+	KotlinDialect: {
+		name:      "Kotlin",
+		operators: []dialectOperator{{"..<", true}, {" until ", true}, {"..", false}},
+	},
+	SwiftDialect: {
+		name:      "Swift",
+		operators: []dialectOperator{{"..<", true}, {"...", false}},
+		openStart: []string{"..<", "..."},
+		openEnd:   []string{"..."},
+		infinite:  "...",
+	},
+}
+
+func (dialect RangeDialect) syntax() (dialectSyntax, error) {
+	syntax, ok := dialectSyntaxes[dialect]
+	if !ok {
+		return syntax, errors.New(fmt.Sprintf("Unknown range dialect (%d)", dialect))
+	}
+
+	return syntax, nil
+}
+
+func (dialect RangeDialect) String() string {
+	syntax, err := dialect.syntax()
+	if err != nil {
+		return fmt.Sprintf("RangeDialect(%d)", dialect)
+	}
+
+	return syntax.name
+}
+
+// ParseRangeDialect parses a range literal written in the syntax of a programming
+// language. Since Ranges are closed, an excluded end is stored as the nearest
+// representable value below it, so the Rust range "1..5" contains 4.5 but not 5.
+func ParseRangeDialect(srange string, dialect RangeDialect) (Range, error) {
+	syntax, err := dialect.syntax()
+	if err != nil {
+		return Range{}, err
+	}
+
+	srange = strings.TrimSpace(srange)
+	if syntax.infinite != "" && srange == syntax.infinite {
+		return Range{Start: math.Inf(-1), End: math.Inf(1)}, nil
+	}
+
+	options := ParseOptions{TrimSpace: true}
+
+	for _, operator := range syntax.operators {
+		index := strings.Index(srange, operator.operator)
+		if index < 0 {
+			continue
+		}
+
+		sstart := strings.TrimSpace(srange[:index])
+		send := strings.TrimSpace(srange[index+len(operator.operator):])
+
+		if sstart == "" || (syntax.open != "" && sstart == syntax.open) {
+			if !containsString(syntax.openStart, operator.operator) {
+				return Range{}, dialectError(srange, syntax, errors.New("unbounded start is not allowed"))
+			}
+			sstart = ""
+		}
+
+		if send == "" || (syntax.open != "" && send == syntax.open) {
+			if !containsString(syntax.openEnd, operator.operator) {
+				return Range{}, dialectError(srange, syntax, errors.New("unbounded end is not allowed"))
+			}
+			send = ""
+		}
+
+		start, err := options.parseBound(sstart, math.Inf(-1))
+		if err != nil {
+			return Range{}, dialectError(srange, syntax, err)
+		}
+
+		end, err := options.parseBound(send, math.Inf(1))
+		if err != nil {
+			return Range{}, dialectError(srange, syntax, err)
+		}
+
+		if operator.exclusive && end != math.Inf(1) {
+			end = math.Nextafter(end, math.Inf(-1))
+		}
+
+		return NewRange(start, end)
+	}
+
+	return Range{}, dialectError(srange, syntax, errors.New("no range operator"))
+}
+
+// FormatDialect renders a range as a range literal in the syntax of a programming
+// language. An end one representable value below an integer, such as one produced by
+// parsing an exclusive range, is rendered with the dialect's exclusive operator. It will
+// return an error if the dialect cannot represent the range.
+func (r Range) FormatDialect(dialect RangeDialect) (string, error) {
+	syntax, err := dialect.syntax()
+	if err != nil {
+		return "", err
+	}
+
+	if r.Infinite() {
+		if syntax.infinite == "" {
+			return "", errors.New(fmt.Sprintf("Range %v cannot be represented in %s", r, syntax.name))
+		}
+		return syntax.infinite, nil
+	}
+
+	sstart, send := FormatFloat(r.Start), FormatFloat(r.End)
+	inclusive, exclusive := "", ""
+	for _, operator := range syntax.operators {
+		if operator.exclusive && exclusive == "" {
+			exclusive = operator.operator
+		} else if !operator.exclusive && inclusive == "" {
+			inclusive = operator.operator
+		}
+	}
+
+	operator := inclusive
+	if bound, ok := adjacentInteger(r.End, math.Inf(1)); ok {
+		operator, send = exclusive, FormatFloat(bound)
+	}
+
+	if r.Start == math.Inf(-1) {
+		if !containsString(syntax.openStart, operator) {
+			return "", errors.New(fmt.Sprintf("Range %v cannot be represented in %s", r, syntax.name))
+		}
+		sstart = ""
+	}
+
+	if r.End == math.Inf(1) {
+		if len(syntax.openEnd) == 0 {
+			return "", errors.New(fmt.Sprintf("Range %v cannot be represented in %s", r, syntax.name))
+		}
+		operator, send = syntax.openEnd[len(syntax.openEnd)-1], ""
+	}
+
+	return sstart + operator + send, nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, candidate := range values {
+		if candidate == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func dialectError(srange string, syntax dialectSyntax, err error) error {
+	return errors.New(fmt.Sprintf("Error parsing %s range (%s): %v", syntax.name, srange, err))
+}
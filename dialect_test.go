@@ -0,0 +1,130 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+func dialectTest(t *testing.T, dialect RangeDialect, literals map[string]Range) {
+	for literal, expectedRange := range literals {
+		grange, err := ParseRangeDialect(literal, dialect)
+
+		if err != nil || grange != expectedRange {
+			t.Errorf("Failed! %v literal: %s, Expected: %v, Got: %v, Error: %v", dialect, literal, expectedRange, grange, err)
+		}
+	}
+}
+
+func dialectFailureTest(t *testing.T, dialect RangeDialect, literals []string) {
+	for _, literal := range literals {
+		grange, err := ParseRangeDialect(literal, dialect)
+
+		if err == nil {
+			t.Errorf("Failed! %v literal: %s, Expected failure with: %v", dialect, literal, grange)
+		}
+	}
+}
+
+func below(float float64) float64 {
+	return math.Nextafter(float, math.Inf(-1))
+}
+
+// PARSING:
+// Parses Rust range literals
+func TestParseRustDialect(t *testing.T) {
+	dialectTest(t, RustDialect, map[string]Range{
+		"1..5":    {Start: 1, End: below(5)},
+		"1..=5":   {Start: 1, End: 5},
+		"1.5..2":  {Start: 1.5, End: below(2)},
+		"1..":     {Start: 1, End: math.Inf(1)},
+		"..5":     {Start: math.Inf(-1), End: below(5)},
+		"..=5":    {Start: math.Inf(-1), End: 5},
+		"..":      {Start: math.Inf(-1), End: math.Inf(1)},
+		" -5..0 ": {Start: -5, End: below(0)},
+	})
+	dialectFailureTest(t, RustDialect, []string{"1..=", "5..1", "1..1", "1...5", "1:5"})
+}
+
+// Parses Ruby range literals
+func TestParseRubyDialect(t *testing.T) {
+	dialectTest(t, RubyDialect, map[string]Range{
+		"1..5":     {Start: 1, End: 5},
+		"1...5":    {Start: 1, End: below(5)},
+		"1..":      {Start: 1, End: math.Inf(1)},
+		"1...":     {Start: 1, End: math.Inf(1)},
+		"..5":      {Start: math.Inf(-1), End: 5},
+		"nil...5":  {Start: math.Inf(-1), End: below(5)},
+		"nil..nil": {Start: math.Inf(-1), End: math.Inf(1)},
+	})
+	dialectFailureTest(t, RubyDialect, []string{"5..1", "1..x"})
+}
+
+// Parses Kotlin range literals
+func TestParseKotlinDialect(t *testing.T) {
+	dialectTest(t, KotlinDialect, map[string]Range{
+		"1..5":      {Start: 1, End: 5},
+		"1..<5":     {Start: 1, End: below(5)},
+		"1 until 5": {Start: 1, End: below(5)},
+	})
+	dialectFailureTest(t, KotlinDialect, []string{"1..", "..5", "1 until"})
+}
+
+// Parses Swift range literals
+func TestParseSwiftDialect(t *testing.T) {
+	dialectTest(t, SwiftDialect, map[string]Range{
+		"1...5": {Start: 1, End: 5},
+		"1..<5": {Start: 1, End: below(5)},
+		"1...":  {Start: 1, End: math.Inf(1)},
+		"...5":  {Start: math.Inf(-1), End: 5},
+		"..<5":  {Start: math.Inf(-1), End: below(5)},
+		"...":   {Start: math.Inf(-1), End: math.Inf(1)},
+	})
+	dialectFailureTest(t, SwiftDialect, []string{"1..<", "1..5"})
+}
+
+// Fails to parse unknown dialect
+func TestParseUnknownDialect(t *testing.T) {
+	dialectFailureTest(t, RangeDialect(-1), []string{"1..5"})
+}
+
+// FORMATTING:
+// Formats ranges as dialect literals
+func TestFormatDialect(t *testing.T) {
+	ranges := []Range{
+		{Start: 1, End: 5},
+		{Start: 1, End: below(5)},
+		{Start: 1.5, End: 2.5},
+		{Start: 1, End: math.Inf(1)},
+		{Start: math.Inf(-1), End: 5},
+		{Start: math.Inf(-1), End: below(5)},
+		{Start: math.Inf(-1), End: math.Inf(1)},
+	}
+	expectedLiterals := map[RangeDialect][]string{
+		RustDialect:   {"1..=5", "1..5", "1.5..=2.5", "1..", "..=5", "..5", ".."},
+		RubyDialect:   {"1..5", "1...5", "1.5..2.5", "1..", "..5", "...5", "nil..nil"},
+		KotlinDialect: {"1..5", "1..<5", "1.5..2.5", "", "", "", ""},
+		SwiftDialect:  {"1...5", "1..<5", "1.5...2.5", "1...", "...5", "..<5", "..."},
+	}
+
+	for dialect, literals := range expectedLiterals {
+		for i, grange := range ranges {
+			literal, err := grange.FormatDialect(dialect)
+
+			if literals[i] == "" {
+				if err == nil {
+					t.Errorf("Failed! %v cannot represent %v, Got: %s", dialect, grange, literal)
+				}
+				continue
+			}
+
+			if err != nil || literal != literals[i] {
+				t.Errorf("Failed! %v, Expected: %s, Got: %s, Error: %v", dialect, literals[i], literal, err)
+			}
+
+			parsed, err := ParseRangeDialect(literal, dialect)
+			if err != nil || parsed != grange {
+				t.Errorf("Failed! %v literal %s did not parse back to %v", dialect, literal, grange)
+			}
+		}
+	}
+}
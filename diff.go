@@ -0,0 +1,119 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RangeDiff describes the values added and removed between two RangeCollections
+type RangeDiff struct {
+	Added   RangeCollection `json:"added"`
+	Removed RangeCollection `json:"removed"`
+}
+
+// Diff returns the values added and removed going from one RangeCollection to another
+func Diff(before RangeCollection, after RangeCollection) RangeDiff {
+	return RangeDiff{Added: after.Subtract(before), Removed: before.Subtract(after)}
+}
+
+// Empty tests if a RangeDiff has no changes
+func (diff RangeDiff) Empty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0
+}
+
+// Apply returns the merged RangeCollection produced by removing and then adding the
+// values in the RangeDiff. Applying Diff(before, after) to before reconstructs the values
+// of after. An added Range is joined to a remaining Range of the collection that it meets
+// with no representable value between them, since Diff cut it from a Range of after that
+// continued there, while other Ranges that touch are kept apart like Merge keeps them.
+// When neither before nor after has Ranges that touch, after is reconstructed exactly.
+func (diff RangeDiff) Apply(collection RangeCollection) RangeCollection {
+	remaining := collection.Subtract(diff.Removed)
+	added := NewRangeCollection(diff.Added).Merge()
+
+	starts, ends := map[float64]bool{}, map[float64]bool{}
+	for _, grange := range added {
+		starts[grange.Start], ends[grange.End] = true, true
+	}
+	remainingStarts, remainingEnds := map[float64]bool{}, map[float64]bool{}
+	for _, grange := range remaining {
+		remainingStarts[grange.Start], remainingEnds[grange.End] = true, true
+	}
+
+	applied := RangeCollection{}
+	for _, grange := range remaining.Union(added) {
+		last := len(applied) - 1
+		if last >= 0 && applied[last].touches(grange) && !applied[last].Overlap(grange) &&
+			(remainingEnds[applied[last].End] && starts[grange.Start] || ends[applied[last].End] && remainingStarts[grange.Start]) {
+			applied[last].End = grange.End
+		} else {
+			applied = append(applied, grange)
+		}
+	}
+
+	return applied
+}
+
+// Reverse returns a RangeDiff that undoes this one
+func (diff RangeDiff) Reverse() RangeDiff {
+	return RangeDiff{Added: diff.Removed, Removed: diff.Added}
+}
+
+// Format renders a RangeDiff in a unified format, with one range per line prefixed by
+// "-" if it was removed or "+" if it was added, ordered by Start
+func (diff RangeDiff) Format(delimiter string) string {
+	type line struct {
+		grange Range
+		prefix string
+	}
+
+	lines := []line{}
+	for _, grange := range diff.Removed {
+		lines = append(lines, line{grange, "-"})
+	}
+	for _, grange := range diff.Added {
+		lines = append(lines, line{grange, "+"})
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].grange.Start < lines[j].grange.Start
+	})
+
+	builder := strings.Builder{}
+	for _, line := range lines {
+		builder.WriteString(line.prefix + line.grange.Format(delimiter) + "\n")
+	}
+
+	return builder.String()
+}
+
+// ParseRangeDiff parses a RangeDiff in the unified format produced by RangeDiff.Format,
+// ignoring blank lines
+func ParseRangeDiff(sdiff string, delimiter string) (RangeDiff, error) {
+	diff := RangeDiff{Added: RangeCollection{}, Removed: RangeCollection{}}
+
+	for i, line := range strings.Split(sdiff, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		grange, err := ParseRange(line[1:], delimiter)
+		if err != nil {
+			return diff, errors.New(fmt.Sprintf("Line %d: %v", i+1, err))
+		}
+
+		switch line[0] {
+		case '+':
+			diff.Added = append(diff.Added, grange)
+		case '-':
+			diff.Removed = append(diff.Removed, grange)
+		default:
+			return diff, errors.New(fmt.Sprintf("Line %d: expected + or -, found %q", i+1, line[0]))
+		}
+	}
+
+	return diff, nil
+}
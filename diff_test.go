@@ -0,0 +1,145 @@
+package gorange
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// DIFFING:
+// Diffs two RangeCollections
+func TestDiffRangeCollections(t *testing.T) {
+	before := RangeCollection{Range{Start: 0, End: 10}, Range{Start: 20, End: 30}}
+	after := RangeCollection{Range{Start: 0, End: 5}, Range{Start: 20, End: 35}, Range{Start: 50, End: math.Inf(1)}}
+
+	diff := Diff(before, after)
+	expectedAdded := RangeCollection{Range{Start: math.Nextafter(30, math.Inf(1)), End: 35}, Range{Start: 50, End: math.Inf(1)}}
+	expectedRemoved := RangeCollection{Range{Start: math.Nextafter(5, math.Inf(1)), End: 10}}
+
+	if !diff.Added.Equal(expectedAdded) || !diff.Removed.Equal(expectedRemoved) {
+		t.Errorf("Failed! Expected added %v and removed %v, Got: %v", expectedAdded, expectedRemoved, diff)
+	}
+
+	if !Diff(after, after).Empty() || diff.Empty() {
+		t.Errorf("Failed! Empty did not match %v", diff)
+	}
+}
+
+// Applies and reverses a diff
+func TestApplyRangeDiff(t *testing.T) {
+	before := RangeCollection{Range{Start: 3, End: 4}, Range{Start: math.Inf(-1), End: 1}}
+	after := RangeCollection{Range{Start: 0, End: 2}, Range{Start: 3.5, End: 8}}
+	diff := Diff(before, after)
+
+	if applied := diff.Apply(before); !applied.Equal(after.Merge()) {
+		t.Errorf("Failed! Expected: %v, Got: %v", after.Merge(), applied)
+	}
+
+	if reverted := diff.Reverse().Apply(after); !reverted.Equal(before.Merge()) {
+		t.Errorf("Failed! Expected: %v, Got: %v", before.Merge(), reverted)
+	}
+}
+
+// Keeps touching ranges apart when applying a diff
+func TestApplyRangeDiffTouching(t *testing.T) {
+	after := RangeCollection{Range{Start: 0, End: 5}}.Union(RangeCollection{Range{Start: 0, End: 10}}.Subtract(RangeCollection{Range{Start: 0, End: 5}}))
+	before := RangeCollection{Range{Start: 20, End: 30}}
+
+	if applied := Diff(before, after).Apply(before); !applied.Equal(after) {
+		t.Errorf("Failed! Expected: %v, Got: %v", after, applied)
+	}
+
+	extended := RangeCollection{Range{Start: 0, End: 10}}
+	if applied := Diff(RangeCollection{Range{Start: 0, End: 5}}, extended).Apply(RangeCollection{Range{Start: 0, End: 5}}); !applied.Equal(extended) {
+		t.Errorf("Failed! Expected: %v, Got: %v", extended, applied)
+	}
+}
+
+// Reconstructs random RangeCollections built with Subtract and Union
+func TestApplyRangeDiffRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 2000; i++ {
+		before, after := randomDiffCollection(random), randomDiffCollection(random)
+		diff := Diff(before, after)
+
+		applied := diff.Apply(before)
+		if !Diff(applied, after).Empty() || !applied.IsMerged() {
+			t.Fatalf("Failed! Applying %v to %v, Expected: %v, Got: %v", diff, before, after, applied)
+		}
+
+		if !hasTouchingRanges(before) && !hasTouchingRanges(after) && !applied.Equal(after) {
+			t.Fatalf("Failed! Applying %v to %v, Expected: %v, Got: %v", diff, before, after, applied)
+		}
+
+		if reverted := diff.Reverse().Apply(after); !Diff(reverted, before).Empty() {
+			t.Fatalf("Failed! Reverting %v from %v, Expected: %v, Got: %v", diff, after, before, reverted)
+		}
+
+		// With no values nearby, the added Ranges are after itself
+		shifted := after.Shift(1000)
+		if applied := Diff(before, shifted).Apply(before); !applied.Equal(shifted) {
+			t.Fatalf("Failed! Expected: %v, Got: %v", shifted, applied)
+		}
+	}
+}
+
+// randomDiffCollection builds a RangeCollection with Subtract and Union, which may leave
+// Ranges with no representable value between them
+func randomDiffCollection(random *rand.Rand) RangeCollection {
+	collection := RangeCollection{}
+
+	for i := 0; i < 6; i++ {
+		start := float64(random.Intn(50))
+		grange := RangeCollection{Range{Start: start, End: start + float64(random.Intn(10))}}
+
+		switch random.Intn(3) {
+		case 0:
+			collection = collection.Union(grange)
+		case 1:
+			collection = collection.Union(grange.Subtract(collection))
+		default:
+			collection = collection.Subtract(grange)
+		}
+	}
+
+	return collection
+}
+
+func hasTouchingRanges(collection RangeCollection) bool {
+	for i := 1; i < len(collection); i++ {
+		if collection[i-1].touches(collection[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FORMATTING:
+// Formats and parses unified diff
+func TestFormatRangeDiff(t *testing.T) {
+	diff := RangeDiff{
+		Added:   RangeCollection{Range{Start: 12, End: 15}, Range{Start: 40, End: math.Inf(1)}},
+		Removed: RangeCollection{Range{Start: -5, End: 10}},
+	}
+	expected := "--5:10\n+12:15\n+40:\n"
+
+	if formatted := diff.Format(":"); formatted != expected {
+		t.Errorf("Failed! Expected: %q, Got: %q", expected, formatted)
+	}
+
+	parsed, err := ParseRangeDiff(expected+"\n", ":")
+	if err != nil || !parsed.Added.Equal(diff.Added) || !parsed.Removed.Equal(diff.Removed) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", diff, parsed, err)
+	}
+}
+
+// Fails to parse invalid unified diff
+func TestParseInvalidRangeDiff(t *testing.T) {
+	for _, sdiff := range []string{"+1:2\n 3:4", "+1:2\n-x", "*1:2"} {
+		if diff, err := ParseRangeDiff(sdiff, ":"); err == nil {
+			t.Errorf("Failed! Expected failure with: %v", diff)
+		}
+	}
+}
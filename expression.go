@@ -0,0 +1,173 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const expressionOperators = "|&!()"
+
+// ExpressionError describes a failure to parse a range expression and the position in
+// the expression at which it occurred
+type ExpressionError struct {
+	Expression string
+	Position   int
+	Message    string
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("Error parsing range expression (%s) at position %d: %s", e.Expression, e.Position, e.Message)
+}
+
+// ParseRangeExpression parses and evaluates a range expression into a merged
+// RangeCollection. Operands are ranges in any form accepted by ParseRange and may be
+// combined with "|" (union), "&" (intersection) and "!" (complement), and grouped
+// with parentheses. "!" binds tightest, followed by "&", then "|", so
+// "0:100 | 200:300 & !250" is evaluated as "0:100 | (200:300 & (!250))".
+// The delimiter may not contain any of the operator characters.
+func ParseRangeExpression(expression string, delimiter string) (RangeCollection, error) {
+	if delimiter == "" || strings.ContainsAny(delimiter, expressionOperators) {
+		return RangeCollection{}, errors.New(fmt.Sprintf("Invalid range expression delimiter (%s)", delimiter))
+	}
+
+	parser := expressionParser{expression: expression, delimiter: delimiter}
+
+	collection, err := parser.parseUnion()
+	if err != nil {
+		return RangeCollection{}, err
+	}
+
+	parser.skipSpace()
+	if parser.position < len(parser.expression) {
+		return RangeCollection{}, parser.error(fmt.Sprintf("unexpected %q", parser.expression[parser.position]))
+	}
+
+	return collection, nil
+}
+
+// FormatRangeExpression renders a RangeCollection as a simplified range expression that
+// ParseRangeExpression will evaluate back to the same merged RangeCollection
+func FormatRangeExpression(collection RangeCollection, delimiter string) string {
+	merged := NewRangeCollection(collection).Merge()
+
+	if len(merged) == 0 {
+		return "!" + delimiter
+	}
+
+	return strings.Join(merged.Format(delimiter), " | ")
+}
+
+type expressionParser struct {
+	expression string
+	delimiter  string
+	position   int
+}
+
+func (p *expressionParser) error(message string) error {
+	return &ExpressionError{Expression: p.expression, Position: p.position, Message: message}
+}
+
+func (p *expressionParser) skipSpace() {
+	for p.position < len(p.expression) && unicode.IsSpace(rune(p.expression[p.position])) {
+		p.position++
+	}
+}
+
+func (p *expressionParser) accept(operator byte) bool {
+	p.skipSpace()
+	if p.position < len(p.expression) && p.expression[p.position] == operator {
+		p.position++
+		return true
+	}
+
+	return false
+}
+
+func (p *expressionParser) parseUnion() (RangeCollection, error) {
+	collection, err := p.parseIntersection()
+	if err != nil {
+		return collection, err
+	}
+
+	for p.accept('|') {
+		other, err := p.parseIntersection()
+		if err != nil {
+			return collection, err
+		}
+		collection = collection.Union(other)
+	}
+
+	return collection, nil
+}
+
+func (p *expressionParser) parseIntersection() (RangeCollection, error) {
+	collection, err := p.parseComplement()
+	if err != nil {
+		return collection, err
+	}
+
+	for p.accept('&') {
+		other, err := p.parseComplement()
+		if err != nil {
+			return collection, err
+		}
+		collection = collection.Intersect(other)
+	}
+
+	return collection, nil
+}
+
+func (p *expressionParser) parseComplement() (RangeCollection, error) {
+	if p.accept('!') {
+		collection, err := p.parseComplement()
+		if err != nil {
+			return collection, err
+		}
+		return collection.Complement(), nil
+	}
+
+	return p.parseOperand()
+}
+
+func (p *expressionParser) parseOperand() (RangeCollection, error) {
+	if p.accept('(') {
+		start := p.position - 1
+
+		collection, err := p.parseUnion()
+		if err != nil {
+			return collection, err
+		}
+
+		if !p.accept(')') {
+			p.position = start
+			return collection, p.error("unclosed parenthesis")
+		}
+
+		return collection, nil
+	}
+
+	p.skipSpace()
+	start := p.position
+	for p.position < len(p.expression) &&
+		!strings.ContainsRune(expressionOperators, rune(p.expression[p.position])) &&
+		!unicode.IsSpace(rune(p.expression[p.position])) {
+		p.position++
+	}
+
+	if start == p.position {
+		if p.position == len(p.expression) {
+			return RangeCollection{}, p.error("expected range, found end of expression")
+		}
+		return RangeCollection{}, p.error(fmt.Sprintf("expected range, found %q", p.expression[p.position]))
+	}
+
+	grange, err := ParseRange(p.expression[start:p.position], p.delimiter)
+	if err != nil {
+		p.position = start
+		return RangeCollection{}, p.error(err.Error())
+	}
+
+	return RangeCollection{grange}, nil
+}
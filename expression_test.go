@@ -0,0 +1,113 @@
+package gorange
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func expressionTest(t *testing.T, expression string, expectedCollection RangeCollection) {
+	collection, err := ParseRangeExpression(expression, ":")
+
+	if err != nil || !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expression: %s, Expected: %v, Got: %v, Error: %v", expression, expectedCollection, collection, err)
+	}
+}
+
+// PARSING:
+// Parses single range expression
+func TestParseSingleRangeExpression(t *testing.T) {
+	expressionTest(t, "3:4", RangeCollection{Range{Start: 3, End: 4}})
+}
+
+// Parses union expression
+func TestParseUnionRangeExpression(t *testing.T) {
+	expressionTest(t, "5:10 | 0:3 | 2:4", RangeCollection{Range{Start: 0, End: 4}, Range{Start: 5, End: 10}})
+}
+
+// Parses intersection expression
+func TestParseIntersectionRangeExpression(t *testing.T) {
+	expressionTest(t, "0:10 & 5: & :7", RangeCollection{Range{Start: 5, End: 7}})
+}
+
+// Parses complement expression
+func TestParseComplementRangeExpression(t *testing.T) {
+	expressionTest(t, "!:5", RangeCollection{Range{Start: math.Nextafter(5, math.Inf(1)), End: math.Inf(1)}})
+	expressionTest(t, "!!3:4", RangeCollection{Range{Start: 3, End: 4}})
+	expressionTest(t, "!:", RangeCollection{})
+}
+
+// Parses grouped expression
+func TestParseGroupedRangeExpression(t *testing.T) {
+	expectedCollection := RangeCollection{
+		Range{Start: 0, End: math.Nextafter(50, math.Inf(-1))},
+		Range{Start: math.Nextafter(60, math.Inf(1)), End: 100},
+		Range{Start: 200, End: 300},
+	}
+
+	expressionTest(t, "(0:100 | 200:300) & !50:60", expectedCollection)
+}
+
+// Applies operator precedence
+func TestRangeExpressionPrecedence(t *testing.T) {
+	expressionTest(t, "0:1 | 5:10 & 8:20", RangeCollection{Range{Start: 0, End: 1}, Range{Start: 8, End: 10}})
+	expressionTest(t, "(0:1 | 5:10) & 8:20", RangeCollection{Range{Start: 8, End: 10}})
+	expressionTest(t, "!0: & :-5", RangeCollection{Range{Start: math.Inf(-1), End: -5}})
+}
+
+// Reports position of invalid expressions
+func TestInvalidRangeExpressionPosition(t *testing.T) {
+	positions := map[string]int{
+		"1:2 |":       5,
+		"1:2 | a:b":   6,
+		"(1:2 | 3:4":  0,
+		"1:2 3:4":     4,
+		"1:2 & )":     6,
+		"(1:2) & 4:1": 8,
+		"":            0,
+	}
+
+	for expression, position := range positions {
+		_, err := ParseRangeExpression(expression, ":")
+
+		var expressionError *ExpressionError
+		if !errors.As(err, &expressionError) || expressionError.Position != position {
+			t.Errorf("Failed! Expression: %s, Expected error at: %d, Got: %v", expression, position, err)
+		}
+	}
+}
+
+// Rejects delimiters containing operators
+func TestInvalidRangeExpressionDelimiter(t *testing.T) {
+	collection, err := ParseRangeExpression("1|2", "|")
+
+	if err == nil {
+		t.Errorf("Failed! Expected failure with: %v", collection)
+	}
+}
+
+// FORMATTING:
+// Formats simplified expression
+func TestFormatRangeExpression(t *testing.T) {
+	expressions := map[string]string{
+		"3:4 | 1:3.5 | 7":   "1:4 | 7",
+		":0 | 10:":          ":0 | 10:",
+		"!: | !:":           "!:",
+		":":                 ":",
+		"!(:-1 | 1:) & -2:": "-0.9999999999999999:0.9999999999999999",
+	}
+
+	for expression, expected := range expressions {
+		collection, err := ParseRangeExpression(expression, ":")
+		formatted := FormatRangeExpression(collection, ":")
+
+		if err != nil || formatted != expected {
+			t.Errorf("Failed! Expression: %s, Expected: %s, Got: %s, Error: %v", expression, expected, formatted, err)
+		}
+
+		reparsed, err := ParseRangeExpression(formatted, ":")
+		if err != nil || !reparsed.Equal(collection) {
+			t.Errorf("Failed! Formatted expression %s did not parse back to %v", formatted, collection)
+		}
+	}
+}
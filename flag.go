@@ -0,0 +1,145 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RangeFlag is a flag.Value and pflag.Value that parses a single Range
+type RangeFlag struct {
+	Range Range
+	// Delimiter separates the bounds of the range. Defaults to ":".
+	Delimiter string
+	// Bounds, if set, is the Range that the parsed Range must fall within
+	Bounds *Range
+}
+
+// NewRangeFlag creates a RangeFlag with a default value, delimiter and optional bounds
+func NewRangeFlag(value Range, delimiter string, bounds *Range) *RangeFlag {
+	return &RangeFlag{Range: value, Delimiter: delimiter, Bounds: bounds}
+}
+
+// String returns the flag's value in the form accepted by Set
+func (f *RangeFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.Range.Format(flagDelimiter(f.Delimiter))
+}
+
+// Set parses the flag's value
+func (f *RangeFlag) Set(value string) error {
+	grange, err := ParseRange(value, flagDelimiter(f.Delimiter))
+	if err != nil {
+		return err
+	}
+
+	if err := checkFlagBounds(grange, f.Bounds, flagDelimiter(f.Delimiter)); err != nil {
+		return err
+	}
+
+	f.Range = grange
+	return nil
+}
+
+// Type returns the flag's type name for pflag
+func (f *RangeFlag) Type() string {
+	return "range"
+}
+
+// Usage describes the accepted syntax of the flag
+func (f *RangeFlag) Usage() string {
+	return flagUsage(flagDelimiter(f.Delimiter), f.Bounds, false)
+}
+
+// RangeCollectionFlag is a flag.Value and pflag.Value that parses a RangeCollection.
+// Each use of the flag may contain a comma separated list of Ranges, and repeated uses
+// accumulate.
+type RangeCollectionFlag struct {
+	Collection RangeCollection
+	// Delimiter separates the bounds of each range. Defaults to ":".
+	Delimiter string
+	// Bounds, if set, is the Range that every parsed Range must fall within
+	Bounds *Range
+
+	set bool
+}
+
+// NewRangeCollectionFlag creates a RangeCollectionFlag with a default value, delimiter
+// and optional bounds. The default value is replaced, rather than added to, by the
+// first use of the flag.
+func NewRangeCollectionFlag(value RangeCollection, delimiter string, bounds *Range) *RangeCollectionFlag {
+	return &RangeCollectionFlag{Collection: value, Delimiter: delimiter, Bounds: bounds}
+}
+
+// String returns the flag's value in the form accepted by Set
+func (f *RangeCollectionFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return strings.Join(f.Collection.Format(flagDelimiter(f.Delimiter)), ",")
+}
+
+// Set parses a comma separated list of Ranges and adds them to the flag's value
+func (f *RangeCollectionFlag) Set(value string) error {
+	collection, err := ParseRangeCollection(strings.Split(value, ","), flagDelimiter(f.Delimiter))
+	if err != nil {
+		return err
+	}
+
+	for _, grange := range collection {
+		if err := checkFlagBounds(grange, f.Bounds, flagDelimiter(f.Delimiter)); err != nil {
+			return err
+		}
+	}
+
+	if !f.set {
+		f.Collection, f.set = RangeCollection{}, true
+	}
+
+	f.Collection = f.Collection.Union(collection)
+	return nil
+}
+
+// Type returns the flag's type name for pflag
+func (f *RangeCollectionFlag) Type() string {
+	return "ranges"
+}
+
+// Usage describes the accepted syntax of the flag
+func (f *RangeCollectionFlag) Usage() string {
+	return flagUsage(flagDelimiter(f.Delimiter), f.Bounds, true)
+}
+
+func flagDelimiter(delimiter string) string {
+	if delimiter == "" {
+		return ":"
+	}
+
+	return delimiter
+}
+
+func checkFlagBounds(grange Range, bounds *Range, delimiter string) error {
+	if bounds != nil && (grange.Start < bounds.Start || grange.End > bounds.End) {
+		return errors.New(fmt.Sprintf("Range %s is outside of %s", grange.Format(delimiter), bounds.Format(delimiter)))
+	}
+
+	return nil
+}
+
+func flagUsage(delimiter string, bounds *Range, collection bool) string {
+	usage := fmt.Sprintf("a range in the form N, N%[1]sM, N%[1]s, %[1]sM or %[1]s", delimiter)
+	if collection {
+		usage = "comma separated ranges, each " + strings.TrimPrefix(usage, "a range ")
+		usage += "; may be repeated"
+	}
+
+	if bounds != nil {
+		usage += fmt.Sprintf("; within %s", bounds.Format(delimiter))
+	}
+
+	return usage
+}
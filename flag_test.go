@@ -0,0 +1,98 @@
+package gorange
+
+import (
+	"flag"
+	"math"
+	"strings"
+	"testing"
+)
+
+var (
+	_ flag.Value = &RangeFlag{}
+	_ flag.Value = &RangeCollectionFlag{}
+)
+
+// RANGE FLAGS:
+// Parses range flag
+func TestRangeFlag(t *testing.T) {
+	rangeFlag := NewRangeFlag(Range{Start: 1, End: 1}, "-", nil)
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.Var(rangeFlag, "pages", rangeFlag.Usage())
+
+	if err := flags.Parse([]string{"--pages", "3-"}); err != nil || rangeFlag.Range != (Range{Start: 3, End: math.Inf(1)}) {
+		t.Errorf("Failed! Got: %v, Error: %v", rangeFlag.Range, err)
+	}
+
+	if rangeFlag.String() != "3-" || rangeFlag.Type() != "range" {
+		t.Errorf("Failed! Expected: 3-, Got: %s", rangeFlag.String())
+	}
+}
+
+// Rejects range flag outside of bounds
+func TestRangeFlagBounds(t *testing.T) {
+	rangeFlag := NewRangeFlag(Range{Start: 1, End: 1}, "", &Range{Start: 1, End: 100})
+
+	if err := rangeFlag.Set("0:5"); err == nil || rangeFlag.Range != (Range{Start: 1, End: 1}) {
+		t.Errorf("Failed! Expected failure with: %v", rangeFlag.Range)
+	}
+
+	if err := rangeFlag.Set("5:"); err == nil {
+		t.Errorf("Failed! Expected failure with: %v", rangeFlag.Range)
+	}
+
+	if err := rangeFlag.Set("5:100"); err != nil {
+		t.Errorf("Failed! Got error: %v", err)
+	}
+}
+
+// Describes range flag syntax
+func TestRangeFlagUsage(t *testing.T) {
+	usage := NewRangeFlag(Range{}, "", &Range{Start: 1, End: 100}).Usage()
+
+	if usage != "a range in the form N, N:M, N:, :M or :; within 1:100" {
+		t.Errorf("Failed! Got: %s", usage)
+	}
+}
+
+// RANGE COLLECTION FLAGS:
+// Accumulates repeated range collection flags
+func TestRangeCollectionFlag(t *testing.T) {
+	collectionFlag := NewRangeCollectionFlag(RangeCollection{Range{Start: 100, End: 100}}, "", nil)
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.Var(collectionFlag, "ids", collectionFlag.Usage())
+
+	err := flags.Parse([]string{"--ids", "1:3,10", "--ids", "2:5"})
+	expectedCollection := RangeCollection{Range{Start: 1, End: 5}, Range{Start: 10, End: 10}}
+
+	if err != nil || !collectionFlag.Collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", expectedCollection, collectionFlag.Collection, err)
+	}
+
+	if collectionFlag.String() != "1:5,10" || collectionFlag.Type() != "ranges" {
+		t.Errorf("Failed! Expected: 1:5,10, Got: %s", collectionFlag.String())
+	}
+}
+
+// Rejects invalid range collection flags
+func TestInvalidRangeCollectionFlag(t *testing.T) {
+	collectionFlag := NewRangeCollectionFlag(RangeCollection{}, "", &Range{Start: 0, End: 10})
+
+	for _, value := range []string{"1:2,x", "1:2,5:11", ""} {
+		if err := collectionFlag.Set(value); err == nil {
+			t.Errorf("Failed! Expected failure with: %s", value)
+		}
+	}
+
+	if len(collectionFlag.Collection) != 0 {
+		t.Errorf("Failed! Expected: %v, Got: %v", RangeCollection{}, collectionFlag.Collection)
+	}
+}
+
+// Describes range collection flag syntax
+func TestRangeCollectionFlagUsage(t *testing.T) {
+	usage := NewRangeCollectionFlag(RangeCollection{}, "-", nil).Usage()
+
+	if !strings.HasPrefix(usage, "comma separated ranges, each in the form N, N-M") || !strings.HasSuffix(usage, "may be repeated") {
+		t.Errorf("Failed! Got: %s", usage)
+	}
+}
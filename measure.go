@@ -0,0 +1,162 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Length returns the measure of a range, the distance from its start to its end, which
+// is infinite for open-ended ranges
+func (r Range) Length() float64 {
+	return r.End - r.Start
+}
+
+// Count returns the number of values in a range, the same as len(r.Values()), without
+// enumerating them. It will return an error if the range is open-ended.
+func (r Range) Count() (int, error) {
+	return r.CountStep(1)
+}
+
+// CountStep returns the number of values from the start of a range to its end in steps
+// of step without enumerating them. It will return an error if the range is open-ended,
+// the step is not positive, or the count is too large for an int.
+func (r Range) CountStep(step float64) (int, error) {
+	if !(step > 0) || math.IsInf(step, 0) {
+		return 0, errors.New(fmt.Sprintf("Invalid step: %v", step))
+	} else if math.IsInf(r.Start, 0) || math.IsInf(r.End, 0) {
+		return 0, errors.New(fmt.Sprintf("Range %v is unbounded", r))
+	} else if r.End < r.Start {
+		return 0, nil
+	}
+
+	count := math.Floor(r.Length()/step) + 1
+	if count >= math.MaxInt {
+		return 0, errors.New(fmt.Sprintf("Range %v has too many values to count", r))
+	}
+
+	return int(count), nil
+}
+
+// Count returns the number of values represented by the Ranges in a RangeCollection, the
+// same as len(collection.Values()), without enumerating them. It will return an error if
+// any Range is open-ended.
+func (collection RangeCollection) Count() (int, error) {
+	total := 0
+
+	for _, grange := range NewRangeCollection(collection).Merge() {
+		count, err := grange.Count()
+		if err != nil {
+			return 0, err
+		} else if total > math.MaxInt-count {
+			return 0, errors.New("RangeCollection has too many values to count")
+		}
+		total += count
+	}
+
+	return total, nil
+}
+
+// TotalLength returns the measure of the values in a RangeCollection, counting values
+// shared by overlapping Ranges once
+func (collection RangeCollection) TotalLength() float64 {
+	total := 0.0
+
+	for _, grange := range NewRangeCollection(collection).Merge() {
+		total += grange.Length()
+	}
+
+	return total
+}
+
+// Coverage returns the fraction of a finite Range covered by the values of a
+// RangeCollection. It will return an error if the Range is open-ended or has no length.
+func (collection RangeCollection) Coverage(within Range) (float64, error) {
+	if math.IsInf(within.Start, 0) || math.IsInf(within.End, 0) {
+		return 0, errors.New(fmt.Sprintf("Range %v is unbounded", within))
+	} else if !(within.Length() > 0) {
+		return 0, errors.New(fmt.Sprintf("Range %v has no length", within))
+	}
+
+	return collection.Intersect(RangeCollection{within}).TotalLength() / within.Length(), nil
+}
+
+// Largest returns the merged Range with the greatest length in a RangeCollection, or
+// false if the RangeCollection is empty. Ties are broken by the earliest Range.
+func (collection RangeCollection) Largest() (Range, bool) {
+	return extremeRange(NewRangeCollection(collection).Merge(), func(length float64, best float64) bool {
+		return length > best
+	})
+}
+
+// Smallest returns the merged Range with the least length in a RangeCollection, or false
+// if the RangeCollection is empty. Ties are broken by the earliest Range.
+func (collection RangeCollection) Smallest() (Range, bool) {
+	return extremeRange(NewRangeCollection(collection).Merge(), func(length float64, best float64) bool {
+		return length < best
+	})
+}
+
+// Gaps returns the Complement of a RangeCollection bounded by its first and last Ranges,
+// leaving out the unbounded values before and after it
+func (collection RangeCollection) Gaps() RangeCollection {
+	merged := NewRangeCollection(collection).Merge()
+	if len(merged) == 0 {
+		return RangeCollection{}
+	}
+
+	return merged.Complement().Intersect(RangeCollection{Range{Start: merged[0].Start, End: merged[len(merged)-1].End}})
+}
+
+// GapStats summarizes the gaps between the Ranges of a RangeCollection. The length of a
+// gap is the distance between its neighbouring Ranges.
+type GapStats struct {
+	Count       int     `json:"count"`
+	TotalLength float64 `json:"total_length"`
+	Largest     Range   `json:"largest"`
+	Smallest    Range   `json:"smallest"`
+}
+
+// GapStats returns statistics about the gaps between the Ranges of a RangeCollection.
+// Largest and Smallest are zero Ranges when there are no gaps.
+func (collection RangeCollection) GapStats() GapStats {
+	merged := NewRangeCollection(collection).Merge()
+	stats := GapStats{}
+	largest, smallest := math.Inf(-1), math.Inf(1)
+
+	for i := 1; i < len(merged); i++ {
+		// Ranges with no representable value between them have no gap
+		gap := Range{Start: math.Nextafter(merged[i-1].End, math.Inf(1)), End: math.Nextafter(merged[i].Start, math.Inf(-1))}
+		if gap.Start > gap.End {
+			continue
+		}
+
+		length := merged[i].Start - merged[i-1].End
+
+		stats.Count++
+		stats.TotalLength += length
+		if length > largest {
+			largest, stats.Largest = length, gap
+		}
+		if length < smallest {
+			smallest, stats.Smallest = length, gap
+		}
+	}
+
+	return stats
+}
+
+func extremeRange(merged RangeCollection, better func(float64, float64) bool) (Range, bool) {
+	if len(merged) == 0 {
+		return Range{}, false
+	}
+
+	best := merged[0]
+	for _, grange := range merged[1:] {
+		if better(grange.Length(), best.Length()) {
+			best = grange
+		}
+	}
+
+	return best, true
+}
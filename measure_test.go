@@ -0,0 +1,97 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+// MEASURING:
+// Measures and counts ranges
+func TestRangeLengthCount(t *testing.T) {
+	tests := []struct {
+		grange Range
+		length float64
+		count  int
+	}{
+		{Range{Start: 1, End: 5}, 4, 5},
+		{Range{Start: 1.5, End: 3}, 1.5, 2},
+		{Range{Start: 2, End: 2}, 0, 1},
+	}
+
+	for _, test := range tests {
+		count, err := test.grange.Count()
+		if test.grange.Length() != test.length || count != test.count || err != nil || count != len(test.grange.Values()) {
+			t.Errorf("Failed! Expected length %v and count %v, Got: %v and %v, Error: %v", test.length, test.count, test.grange.Length(), count, err)
+		}
+	}
+
+	if count, err := (Range{Start: 0, End: 1}).CountStep(0.25); count != 5 || err != nil {
+		t.Errorf("Failed! Expected: 5, Got: %v, Error: %v", count, err)
+	}
+
+	if _, err := (Range{Start: 0, End: math.Inf(1)}).Count(); err == nil || !math.IsInf((Range{Start: 0, End: math.Inf(1)}).Length(), 1) {
+		t.Errorf("Failed! Unbounded range should have infinite length and no count")
+	}
+
+	if _, err := (Range{Start: 0, End: 1e300}).Count(); err == nil {
+		t.Errorf("Failed! Count too large for an int should be rejected")
+	}
+}
+
+// Measures and counts collections, counting overlaps once
+func TestRangeCollectionLengthCount(t *testing.T) {
+	collection := RangeCollection{Range{Start: 0, End: 10}, Range{Start: 5, End: 15}, Range{Start: 20, End: 22}}
+
+	if length := collection.TotalLength(); length != 17 {
+		t.Errorf("Failed! Expected: 17, Got: %v", length)
+	}
+
+	if count, err := collection.Count(); count != 19 || err != nil || count != len(collection.Values()) {
+		t.Errorf("Failed! Expected: 19, Got: %v, Error: %v", count, err)
+	}
+
+	if coverage, err := collection.Coverage(Range{Start: 10, End: 30}); coverage != 0.35 || err != nil {
+		t.Errorf("Failed! Expected: 0.35, Got: %v, Error: %v", coverage, err)
+	}
+
+	if _, err := collection.Coverage(Range{Start: 1, End: 1}); err == nil {
+		t.Errorf("Failed! Coverage of a range with no length should be rejected")
+	}
+}
+
+// Finds the largest and smallest ranges and gaps
+func TestRangeCollectionExtremes(t *testing.T) {
+	collection := RangeCollection{Range{Start: 30, End: 31}, Range{Start: 0, End: 10}, Range{Start: 12, End: 20}, Range{Start: 40, End: 41}}
+
+	if largest, ok := collection.Largest(); !ok || !largest.Equal(Range{Start: 0, End: 10}) {
+		t.Errorf("Failed! Expected: 0:10, Got: %v", largest)
+	}
+
+	if smallest, ok := collection.Smallest(); !ok || !smallest.Equal(Range{Start: 30, End: 31}) {
+		t.Errorf("Failed! Expected: 30:31, Got: %v", smallest)
+	}
+
+	if _, ok := (RangeCollection{}).Largest(); ok {
+		t.Errorf("Failed! Empty collection has no largest range")
+	}
+
+	expectedGaps := RangeCollection{
+		Range{Start: math.Nextafter(10, math.Inf(1)), End: math.Nextafter(12, math.Inf(-1))},
+		Range{Start: math.Nextafter(20, math.Inf(1)), End: math.Nextafter(30, math.Inf(-1))},
+		Range{Start: math.Nextafter(31, math.Inf(1)), End: math.Nextafter(40, math.Inf(-1))},
+	}
+	if gaps := collection.Gaps(); !gaps.Equal(expectedGaps) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedGaps, gaps)
+	}
+
+	stats := collection.GapStats()
+	if stats.Count != 3 || stats.TotalLength != 21 || !stats.Largest.Equal(expectedGaps[1]) || !stats.Smallest.Equal(expectedGaps[0]) {
+		t.Errorf("Failed! Unexpected gap stats: %+v", stats)
+	}
+	// Subtract and Union leave ranges with no representable value between them
+	touching := RangeCollection{Range{Start: 0, End: 5}}.Union(RangeCollection{Range{Start: 0, End: 10}}.Subtract(RangeCollection{Range{Start: 0, End: 5}}))
+	touching = touching.Union(RangeCollection{Range{Start: 20, End: 30}})
+	if stats := touching.GapStats(); stats.Count != len(touching.Gaps()) || stats.Count != 1 || !stats.Largest.Equal(touching.Gaps()[0]) {
+		t.Errorf("Failed! Expected one gap like %v, Got: %+v", touching.Gaps(), stats)
+	}
+}
@@ -0,0 +1,93 @@
+package gorange
+
+import (
+	"runtime"
+	"sync"
+)
+
+// minParallelChunk is the smallest number of Ranges worth merging in its own goroutine
+const minParallelChunk = 1 << 12
+
+// ParallelMerge merges the Ranges in this RangeCollection like Merge, sorting and
+// merging chunks of the RangeCollection concurrently and then merging neighbouring
+// chunks pairwise until one remains. workers limits the number of chunks, and defaults
+// to GOMAXPROCS when it is not positive. Unlike Merge, the RangeCollection is not
+// reordered.
+func (collection RangeCollection) ParallelMerge(workers int) RangeCollection {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if chunks := len(collection) / minParallelChunk; chunks < workers {
+		workers = chunks
+	}
+
+	if workers <= 1 {
+		return NewRangeCollection(collection).Merge()
+	}
+
+	sorted := NewRangeCollection(collection)
+	chunks := make([]RangeCollection, workers)
+
+	var wait sync.WaitGroup
+	for i := range chunks {
+		start, end := chunkBoundary(i, len(sorted), workers), chunkBoundary(i+1, len(sorted), workers)
+
+		wait.Add(1)
+		go func(i int, chunk RangeCollection) {
+			defer wait.Done()
+			chunks[i] = chunk.Merge()
+		}(i, sorted[start:end])
+	}
+	wait.Wait()
+
+	for len(chunks) > 1 {
+		merged := make([]RangeCollection, (len(chunks)+1)/2)
+
+		for i := range merged {
+			if 2*i+1 == len(chunks) {
+				merged[i] = chunks[2*i]
+				continue
+			}
+
+			wait.Add(1)
+			go func(i int) {
+				defer wait.Done()
+				merged[i] = mergeSorted(chunks[2*i], chunks[2*i+1])
+			}(i)
+		}
+		wait.Wait()
+
+		chunks = merged
+	}
+
+	return chunks[0]
+}
+
+// chunkBoundary returns the index at which chunk i of length items split into chunks
+// of nearly equal size starts
+func chunkBoundary(i int, length int, chunks int) int {
+	return int(int64(i) * int64(length) / int64(chunks))
+}
+
+// mergeSorted merges two merged RangeCollections into one
+func mergeSorted(left RangeCollection, right RangeCollection) RangeCollection {
+	merged := make(RangeCollection, 0, len(left)+len(right))
+
+	for i, j := 0, 0; i < len(left) || j < len(right); {
+		var next Range
+		if j == len(right) || (i < len(left) && left[i].Start <= right[j].Start) {
+			next, i = left[i], i+1
+		} else {
+			next, j = right[j], j+1
+		}
+
+		if last := len(merged) - 1; last >= 0 && merged[last].Overlap(next) {
+			merged[last], _ = merged[last].Merge(next)
+		} else {
+			merged = append(merged, next)
+		}
+	}
+
+	return merged
+}
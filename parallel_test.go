@@ -0,0 +1,91 @@
+package gorange
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func randomRangeCollection(size int, seed int64) RangeCollection {
+	random := rand.New(rand.NewSource(seed))
+	collection := RangeCollection{}
+
+	for i := 0; i < size; i++ {
+		start := float64(random.Intn(size * 10))
+		collection = append(collection, Range{Start: start, End: start + float64(random.Intn(10))})
+	}
+
+	return collection
+}
+
+// PARALLEL MERGING:
+// Merges like Merge
+func TestParallelMerge(t *testing.T) {
+	for _, workers := range []int{0, 1, 2, 3, 8} {
+		collection := randomRangeCollection(50000, int64(workers))
+		collection = append(collection, Range{Start: math.Inf(-1), End: -5}, Range{Start: 499990, End: math.Inf(1)})
+		original := NewRangeCollection(collection)
+
+		merged := collection.ParallelMerge(workers)
+
+		if expected := NewRangeCollection(collection).Merge(); !merged.Equal(expected) {
+			t.Errorf("Failed! Workers: %d, Expected %d ranges, Got: %d", workers, len(expected), len(merged))
+		}
+
+		if !collection.Equal(original) {
+			t.Errorf("Failed! Workers: %d, ParallelMerge reordered the RangeCollection", workers)
+		}
+	}
+}
+
+// Merges with far more workers than chunks
+func TestParallelMergeManyWorkers(t *testing.T) {
+	collection := randomRangeCollection(13*minParallelChunk+1, 1)
+
+	if merged, expected := collection.ParallelMerge(1<<30), NewRangeCollection(collection).Merge(); !merged.Equal(expected) {
+		t.Errorf("Failed! Expected %d ranges, Got: %d", len(expected), len(merged))
+	}
+
+	// Chunks stay in bounds and non-empty when there are many more chunks than spare items
+	length, workers := 5000*minParallelChunk+1, 5000
+	for i := 0; i < workers; i++ {
+		if start, end := chunkBoundary(i, length, workers), chunkBoundary(i+1, length, workers); start >= end || end > length {
+			t.Fatalf("Failed! Chunk %d spans %d to %d of %d", i, start, end, length)
+		}
+	}
+
+	if last := chunkBoundary(workers, length, workers); last != length {
+		t.Errorf("Failed! Expected the last chunk to end at %d, Got: %d", length, last)
+	}
+}
+
+// Merges small and empty RangeCollections
+func TestParallelMergeSmall(t *testing.T) {
+	collection := RangeCollection{Range{Start: 5, End: 7}, Range{Start: 1, End: 6}}
+
+	if merged := collection.ParallelMerge(4); !merged.Equal(RangeCollection{Range{Start: 1, End: 7}}) {
+		t.Errorf("Failed! Expected: %v, Got: %v", RangeCollection{Range{Start: 1, End: 7}}, merged)
+	}
+
+	if merged := (RangeCollection{}).ParallelMerge(4); len(merged) != 0 {
+		t.Errorf("Failed! Expected: %v, Got: %v", RangeCollection{}, merged)
+	}
+}
+
+func BenchmarkMerge(b *testing.B) {
+	collection := randomRangeCollection(1000000, 1)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		NewRangeCollection(collection).Merge()
+	}
+}
+
+func BenchmarkParallelMerge(b *testing.B) {
+	collection := randomRangeCollection(1000000, 1)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		collection.ParallelMerge(0)
+	}
+}
@@ -0,0 +1,235 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseOptions configures how ParseRangeWithOptions parses ranges. The zero value
+// parses ranges exactly like ParseRange with a ":" delimiter.
+type ParseOptions struct {
+	// Delimiters are the accepted separators between the bounds of a range, tried in
+	// order. Defaults to ":".
+	Delimiters []string
+	// Parser parses each bound once it has been normalized. Defaults to ParseFloat.
+	Parser ValueParser
+	// TrimSpace ignores whitespace around a range and its bounds
+	TrimSpace bool
+	// BasePrefixes accepts integers with "0x", "0o" and "0b" prefixes
+	BasePrefixes bool
+	// DigitSeparators accepts underscores between digits, such as "1_000" or "0xff_ff"
+	DigitSeparators bool
+	// UnicodeMinus accepts U+2212 MINUS SIGN, U+FE63 SMALL HYPHEN-MINUS and U+FF0D
+	// FULLWIDTH HYPHEN-MINUS in place of "-"
+	UnicodeMinus bool
+	// Infinity accepts "inf", "infinity" and "∞", optionally signed, as bounds even
+	// when Parser does not
+	Infinity bool
+}
+
+// PermissiveParseOptions enables every normalization and accepts "..=", "..", ":" and
+// "-" as delimiters
+var PermissiveParseOptions = ParseOptions{
+	Delimiters:      []string{"..=", "..", ":", "-"},
+	TrimSpace:       true,
+	BasePrefixes:    true,
+	DigitSeparators: true,
+	UnicodeMinus:    true,
+	Infinity:        true,
+}
+
+var unicodeMinusSigns = []string{"−", "﹣", "－"}
+
+// ParseRangeWithOptions parses a range from a string like ParseRange, normalizing the
+// range and its bounds as configured by options. Unlike ParseRange, a string that parses
+// as a single value is always a singleton range, so with a "-" delimiter "-5" is -5 rather than
+// ":5". Otherwise every occurrence of every delimiter is tried until one splits the
+// string into two parseable bounds, so "-5--3" is parsed as -5:-3.
+func ParseRangeWithOptions(srange string, options ParseOptions) (Range, error) {
+	delimiters := options.Delimiters
+	if len(delimiters) == 0 {
+		delimiters = []string{":"}
+	}
+
+	if options.TrimSpace {
+		srange = strings.TrimSpace(srange)
+	}
+
+	float, err := options.parseValue(srange)
+	if err == nil {
+		return NewRange(float, float)
+	}
+
+	for _, delimiter := range delimiters {
+		if delimiter == "" {
+			continue
+		}
+
+		for i := strings.Index(srange, delimiter); i >= 0; {
+			start, startErr := options.parseBound(srange[:i], math.Inf(-1))
+			end, endErr := options.parseBound(srange[i+len(delimiter):], math.Inf(1))
+
+			if startErr == nil && endErr == nil {
+				return NewRange(start, end)
+			} else if startErr != nil {
+				err = startErr
+			} else {
+				err = endErr
+			}
+
+			next := strings.Index(srange[i+1:], delimiter)
+			if next < 0 {
+				break
+			}
+			i += next + 1
+		}
+	}
+
+	return Range{}, parsingError(srange, strings.Join(delimiters, ", "), err)
+}
+
+// ParseRangeCollectionWithOptions parses a list of Ranges like ParseRangeCollection,
+// normalizing each Range as configured by options
+func ParseRangeCollectionWithOptions(collection []string, options ParseOptions) (RangeCollection, error) {
+	rcollection := RangeCollection{}
+
+	for _, srange := range collection {
+		grange, err := ParseRangeWithOptions(srange, options)
+		if err != nil {
+			return rcollection, err
+		}
+		rcollection = append(rcollection, grange)
+	}
+
+	return rcollection, nil
+}
+
+func (options ParseOptions) parseBound(svalue string, open float64) (float64, error) {
+	if options.TrimSpace {
+		svalue = strings.TrimSpace(svalue)
+	}
+
+	if svalue == "" {
+		return open, nil
+	}
+
+	return options.parseValue(svalue)
+}
+
+func (options ParseOptions) parseValue(svalue string) (float64, error) {
+	parser := options.Parser
+	if parser == nil {
+		parser = ParseFloat
+	}
+
+	if options.TrimSpace {
+		svalue = strings.TrimSpace(svalue)
+	}
+
+	if options.UnicodeMinus {
+		for _, minus := range unicodeMinusSigns {
+			svalue = strings.ReplaceAll(svalue, minus, "-")
+		}
+	}
+
+	if options.DigitSeparators {
+		var err error
+		svalue, err = removeDigitSeparators(svalue)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if options.Infinity {
+		if float, ok := parseInfinity(svalue); ok {
+			return float, nil
+		}
+	}
+
+	if options.BasePrefixes {
+		if float, ok, err := parseBasePrefixed(svalue); ok {
+			return float, err
+		}
+	}
+
+	return parser(svalue)
+}
+
+func removeDigitSeparators(svalue string) (string, error) {
+	runes := []rune(svalue)
+	builder := strings.Builder{}
+
+	for i, r := range runes {
+		if r != '_' {
+			builder.WriteRune(r)
+			continue
+		}
+
+		if i == 0 || i == len(runes)-1 || !isDigitRune(runes[i-1]) || !isDigitRune(runes[i+1]) {
+			return svalue, errors.New(fmt.Sprintf("Invalid digit separator in (%s)", svalue))
+		}
+	}
+
+	return builder.String(), nil
+}
+
+func isDigitRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsDigit(r) || unicode.IsLetter(r))
+}
+
+func parseInfinity(svalue string) (float64, bool) {
+	sign := 1.0
+	if strings.HasPrefix(svalue, "-") {
+		sign, svalue = -1, svalue[1:]
+	} else if strings.HasPrefix(svalue, "+") {
+		svalue = svalue[1:]
+	}
+
+	switch strings.ToLower(svalue) {
+	case "inf", "infinity", "∞":
+		return math.Inf(int(sign)), true
+	}
+
+	return 0, false
+}
+
+func parseBasePrefixed(svalue string) (float64, bool, error) {
+	sign, unsigned := 1.0, svalue
+	if strings.HasPrefix(unsigned, "-") {
+		sign, unsigned = -1, unsigned[1:]
+	} else if strings.HasPrefix(unsigned, "+") {
+		unsigned = unsigned[1:]
+	}
+
+	if len(unsigned) < 2 || unsigned[0] != '0' {
+		return 0, false, nil
+	}
+
+	var base int
+	switch unsigned[1] {
+	case 'x', 'X':
+		base = 16
+	case 'o', 'O':
+		base = 8
+	case 'b', 'B':
+		base = 2
+	default:
+		return 0, false, nil
+	}
+
+	// Values such as the byte size 0B are left to the parser unless digits follow
+	if len(unsigned) < 3 || !strings.ContainsRune("0123456789abcdef"[:base], unicode.ToLower(rune(unsigned[2]))) {
+		return 0, false, nil
+	}
+
+	value, err := strconv.ParseUint(unsigned[2:], base, 64)
+	if err != nil {
+		return 0, true, err
+	}
+
+	return sign * float64(value), true, nil
+}
@@ -0,0 +1,109 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+func parseOptionsTest(t *testing.T, srange string, options ParseOptions, expectedRange Range) {
+	grange, err := ParseRangeWithOptions(srange, options)
+
+	if err != nil || grange != expectedRange {
+		t.Errorf("Failed! Range: %q, Expected: %v, Got: %v, Error: %v", srange, expectedRange, grange, err)
+	}
+}
+
+func parseOptionsFailureTest(t *testing.T, srange string, options ParseOptions) {
+	grange, err := ParseRangeWithOptions(srange, options)
+
+	if err == nil {
+		t.Errorf("Failed! Range: %q, Expected failure with: %v", srange, grange)
+	}
+}
+
+// DEFAULTS:
+// Parses like ParseRange with zero options
+func TestParseZeroOptions(t *testing.T) {
+	parseOptionsTest(t, "3:4", ParseOptions{}, Range{Start: 3, End: 4})
+	parseOptionsTest(t, ":", ParseOptions{}, Range{Start: math.Inf(-1), End: math.Inf(1)})
+	parseOptionsFailureTest(t, " 3 : 4 ", ParseOptions{})
+	parseOptionsFailureTest(t, "0x10:0xff", ParseOptions{})
+	parseOptionsFailureTest(t, "−5:5", ParseOptions{})
+}
+
+// Parses multi-character delimiters
+func TestParseMultiCharacterDelimiter(t *testing.T) {
+	options := ParseOptions{Delimiters: []string{".."}}
+
+	parseOptionsTest(t, "1.5..2", options, Range{Start: 1.5, End: 2})
+	parseOptionsTest(t, "..2", options, Range{Start: math.Inf(-1), End: 2})
+	parseOptionsTest(t, "1..", options, Range{Start: 1, End: math.Inf(1)})
+	parseOptionsTest(t, "..", options, Range{Start: math.Inf(-1), End: math.Inf(1)})
+}
+
+// NORMALIZATION:
+// Trims whitespace
+func TestParseTrimSpace(t *testing.T) {
+	parseOptionsTest(t, " 3 : 4 ", ParseOptions{TrimSpace: true}, Range{Start: 3, End: 4})
+	parseOptionsTest(t, " 3 : ", ParseOptions{TrimSpace: true}, Range{Start: 3, End: math.Inf(1)})
+}
+
+// Parses base prefixes
+func TestParseBasePrefixes(t *testing.T) {
+	parseOptionsTest(t, "0x10:0xff", ParseOptions{BasePrefixes: true}, Range{Start: 16, End: 255})
+	parseOptionsTest(t, "-0b101:0o17", ParseOptions{BasePrefixes: true}, Range{Start: -5, End: 15})
+	parseOptionsTest(t, "0.5:0.5", ParseOptions{BasePrefixes: true}, Range{Start: 0.5, End: 0.5})
+	parseOptionsFailureTest(t, "0xfg", ParseOptions{BasePrefixes: true})
+	parseOptionsTest(t, "0B:1KiB", ParseOptions{BasePrefixes: true, Parser: ParseByteSize}, Range{Start: 0, End: 1024})
+}
+
+// Parses digit separators
+func TestParseDigitSeparators(t *testing.T) {
+	parseOptionsTest(t, "1_000:2_000.5", ParseOptions{DigitSeparators: true}, Range{Start: 1000, End: 2000.5})
+	parseOptionsTest(t, "0x_ff:0x1_00", ParseOptions{DigitSeparators: true, BasePrefixes: true}, Range{Start: 255, End: 256})
+	parseOptionsFailureTest(t, "1__000", ParseOptions{DigitSeparators: true})
+	parseOptionsFailureTest(t, "_1:2", ParseOptions{DigitSeparators: true})
+}
+
+// Parses unicode minus signs
+func TestParseUnicodeMinus(t *testing.T) {
+	parseOptionsTest(t, "−5:5", ParseOptions{UnicodeMinus: true}, Range{Start: -5, End: 5})
+	parseOptionsTest(t, "－5:﹣1", ParseOptions{UnicodeMinus: true}, Range{Start: -5, End: -1})
+}
+
+// Parses infinity literals
+func TestParseInfinity(t *testing.T) {
+	parseOptionsTest(t, "-∞:∞", ParseOptions{Infinity: true}, Range{Start: math.Inf(-1), End: math.Inf(1)})
+	parseOptionsTest(t, "1:+Infinity", ParseOptions{Infinity: true, Parser: ParseByteSize}, Range{Start: 1, End: math.Inf(1)})
+	parseOptionsFailureTest(t, "1:∞", ParseOptions{})
+}
+
+// PERMISSIVE:
+// Parses with every normalization enabled
+func TestParsePermissiveOptions(t *testing.T) {
+	ranges := map[string]Range{
+		" 3 : 4 ":     {Start: 3, End: 4},
+		"0x10..0xff":  {Start: 16, End: 255},
+		"1_000-2_000": {Start: 1000, End: 2000},
+		"−5..=5":      {Start: -5, End: 5},
+		"-5--3":       {Start: -5, End: -3},
+		"-5":          {Start: -5, End: -5},
+		"5-":          {Start: 5, End: math.Inf(1)},
+		"1e-5":        {Start: 1e-5, End: 1e-5},
+		"-∞ .. 0":     {Start: math.Inf(-1), End: 0},
+	}
+
+	for srange, expectedRange := range ranges {
+		parseOptionsTest(t, srange, PermissiveParseOptions, expectedRange)
+	}
+}
+
+// Parses collection with options
+func TestParseRangeCollectionWithOptions(t *testing.T) {
+	expectedCollection := RangeCollection{Range{Start: 1, End: 2}, Range{Start: 16, End: math.Inf(1)}}
+	collection, err := ParseRangeCollectionWithOptions([]string{"1 .. 2", "0x10 .."}, PermissiveParseOptions)
+
+	if err != nil || !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, collection)
+	}
+}
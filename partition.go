@@ -0,0 +1,121 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Split splits a range at each cut point that falls after its start and no later than its
+// end. Each piece but the last ends on the nearest representable value before the next
+// cut point, so the pieces contain every value of the range exactly once.
+func (r Range) Split(at ...float64) RangeCollection {
+	cuts := append([]float64{}, at...)
+	sort.Float64s(cuts)
+
+	pieces := RangeCollection{}
+	current := r
+	for _, cut := range cuts {
+		if cut <= current.Start || cut > current.End {
+			continue
+		}
+
+		pieces = append(pieces, Range{Start: current.Start, End: math.Nextafter(cut, math.Inf(-1))})
+		current.Start = cut
+	}
+
+	return append(pieces, current)
+}
+
+// Chunk splits a finite range into pieces that each span size, starting from the start of
+// the range. The last piece ends on the end of the range and may be shorter. It will
+// return an error if the range is open-ended or size is not positive.
+func (r Range) Chunk(size float64) (RangeCollection, error) {
+	if !(size > 0) || math.IsInf(size, 0) {
+		return RangeCollection{}, errors.New(fmt.Sprintf("Invalid chunk size: %v", size))
+	} else if math.IsInf(r.Start, 0) || math.IsInf(r.End, 0) {
+		return RangeCollection{}, errors.New(fmt.Sprintf("Range %v is unbounded", r))
+	}
+
+	cuts := []float64{}
+	for i := 1.0; r.Start+i*size <= r.End; i++ {
+		cuts = append(cuts, r.Start+i*size)
+	}
+
+	return r.Split(cuts...), nil
+}
+
+// Partition splits a finite range into n pieces of equal length. Fewer pieces are returned
+// when the range is too narrow to hold n distinct pieces. It will return an error if the
+// range is open-ended or n is not positive.
+func (r Range) Partition(n int) (RangeCollection, error) {
+	if n <= 0 {
+		return RangeCollection{}, errors.New(fmt.Sprintf("Invalid number of partitions: %d", n))
+	} else if math.IsInf(r.Start, 0) || math.IsInf(r.End, 0) {
+		return RangeCollection{}, errors.New(fmt.Sprintf("Range %v is unbounded", r))
+	}
+
+	cuts := []float64{}
+	for i := 1; i < n; i++ {
+		cuts = append(cuts, r.Start+r.Length()*float64(i)/float64(n))
+	}
+
+	return r.Split(cuts...), nil
+}
+
+// Rebalance divides the values of a RangeCollection into n merged RangeCollections of
+// roughly equal total length, splitting Ranges where needed. Groups are contiguous and in
+// order, so the first group holds the lowest values. When every Range is a single value,
+// groups hold roughly equal numbers of Ranges instead. It will return an error if any
+// Range is open-ended or n is not positive.
+func (collection RangeCollection) Rebalance(n int) ([]RangeCollection, error) {
+	if n <= 0 {
+		return nil, errors.New(fmt.Sprintf("Invalid number of groups: %d", n))
+	}
+
+	merged := NewRangeCollection(collection).Merge()
+	for _, grange := range merged {
+		if math.IsInf(grange.Start, 0) || math.IsInf(grange.End, 0) {
+			return nil, errors.New(fmt.Sprintf("Range %v is unbounded", grange))
+		}
+	}
+
+	groups := make([]RangeCollection, n)
+	for i := range groups {
+		groups[i] = RangeCollection{}
+	}
+
+	total := merged.TotalLength()
+	if total == 0 {
+		size := (len(merged) + n - 1) / n
+		for i, grange := range merged {
+			groups[i/size] = append(groups[i/size], grange)
+		}
+
+		return groups, nil
+	}
+
+	group, length := 0, 0.0
+	for _, grange := range merged {
+		// Cut the Range wherever the running length crosses the boundary of a group
+		for group < n-1 {
+			boundary := total * float64(group+1) / float64(n)
+			if length+grange.Length() <= boundary {
+				break
+			}
+
+			if cut := math.Min(grange.Start+(boundary-length), grange.End); cut > grange.Start {
+				groups[group] = append(groups[group], Range{Start: grange.Start, End: math.Nextafter(cut, math.Inf(-1))})
+				grange.Start = cut
+			}
+			length = boundary
+			group++
+		}
+
+		groups[group] = append(groups[group], grange)
+		length += grange.Length()
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,96 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+// SPLITTING:
+// Splits a range at cut points
+func TestSplitRange(t *testing.T) {
+	expected := RangeCollection{
+		Range{Start: 0, End: math.Nextafter(3, math.Inf(-1))},
+		Range{Start: 3, End: math.Nextafter(7, math.Inf(-1))},
+		Range{Start: 7, End: 10},
+	}
+
+	if pieces := (Range{Start: 0, End: 10}).Split(7, 3, 0, 3, 20); !pieces.Equal(expected) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expected, pieces)
+	}
+
+	if pieces := (Range{Start: math.Inf(-1), End: 10}).Split(10); !pieces.Equal(RangeCollection{Range{Start: math.Inf(-1), End: math.Nextafter(10, math.Inf(-1))}, Range{Start: 10, End: 10}}) {
+		t.Errorf("Failed! Unexpected pieces: %v", pieces)
+	}
+}
+
+// Chunks and partitions a range
+func TestChunkPartitionRange(t *testing.T) {
+	chunks, err := (Range{Start: 1, End: 25}).Chunk(10)
+	expected := RangeCollection{
+		Range{Start: 1, End: math.Nextafter(11, math.Inf(-1))},
+		Range{Start: 11, End: math.Nextafter(21, math.Inf(-1))},
+		Range{Start: 21, End: 25},
+	}
+	if err != nil || !chunks.Equal(expected) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", expected, chunks, err)
+	}
+
+	// Chunks of integer ranges hold the expected integers
+	if values := chunks[0].Values(); len(values) != 10 || values[9] != 10 {
+		t.Errorf("Failed! Expected 1 to 10, Got: %v", values)
+	}
+
+	parts, err := (Range{Start: 0, End: 1}).Partition(4)
+	if err != nil || len(parts) != 4 || parts[1].Start != 0.25 || parts[3].End != 1 {
+		t.Errorf("Failed! Unexpected partitions: %v, Error: %v", parts, err)
+	}
+
+	if parts, _ := (Range{Start: 5, End: 5}).Partition(3); !parts.Equal(RangeCollection{Range{Start: 5, End: 5}}) {
+		t.Errorf("Failed! Expected a single partition, Got: %v", parts)
+	}
+
+	if _, err := (Range{Start: 0, End: math.Inf(1)}).Partition(2); err == nil {
+		t.Errorf("Failed! Unbounded range should be rejected")
+	}
+
+	if _, err := (Range{Start: 0, End: 1}).Chunk(0); err == nil {
+		t.Errorf("Failed! Chunk size of zero should be rejected")
+	}
+}
+
+// Rebalances a collection into groups of equal measure
+func TestRebalanceRangeCollection(t *testing.T) {
+	collection := RangeCollection{Range{Start: 100, End: 110}, Range{Start: 0, End: 20}}
+
+	groups, err := collection.Rebalance(3)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	expected := []RangeCollection{
+		{Range{Start: 0, End: math.Nextafter(10, math.Inf(-1))}},
+		{Range{Start: 10, End: 20}},
+		{Range{Start: 100, End: 110}},
+	}
+	for i, group := range groups {
+		if !group.Equal(expected[i]) {
+			t.Errorf("Failed! Expected group %d: %v, Got: %v", i, expected[i], group)
+		}
+	}
+
+	groups, _ = collection.Rebalance(4)
+	for i, group := range groups {
+		if length := group.TotalLength(); math.Abs(length-7.5) > 1e-9 {
+			t.Errorf("Failed! Expected group %d to have length 7.5, Got: %v in %v", i, length, group)
+		}
+	}
+
+	singles, _ := RangeCollection{Range{Start: 1, End: 1}, Range{Start: 3, End: 3}, Range{Start: 5, End: 5}}.Rebalance(2)
+	if len(singles[0]) != 2 || len(singles[1]) != 1 {
+		t.Errorf("Failed! Expected groups of 2 and 1, Got: %v", singles)
+	}
+
+	if _, err := (RangeCollection{Range{Start: 0, End: math.Inf(1)}}).Rebalance(2); err == nil {
+		t.Errorf("Failed! Unbounded collection should be rejected")
+	}
+}
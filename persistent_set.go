@@ -0,0 +1,266 @@
+package gorange
+
+import (
+	"math"
+)
+
+// PersistentRangeSet is an immutable set of values. Add and Remove return new versions
+// of the set in O(log n) time, sharing all unchanged structure with the version they
+// were derived from, so every version stays valid and cheap to keep. The zero value is
+// an empty set.
+//
+// The set is stored as a treap of merged Ranges keyed by Start, with priorities derived
+// from each Range's Start so that the shape of the tree depends only on its contents.
+type PersistentRangeSet struct {
+	root *persistentNode
+}
+
+type persistentNode struct {
+	grange   Range
+	priority uint64
+	size     int
+	left     *persistentNode
+	right    *persistentNode
+}
+
+// NewPersistentRangeSet creates a PersistentRangeSet containing the values of the
+// supplied Ranges
+func NewPersistentRangeSet(ranges ...Range) PersistentRangeSet {
+	set := PersistentRangeSet{}
+
+	for _, grange := range NewRangeCollection(ranges).Merge() {
+		set.root = joinNodes(set.root, newPersistentNode(grange))
+	}
+
+	return set
+}
+
+// Add returns a version of the set that also contains the values of grange
+func (set PersistentRangeSet) Add(grange Range) PersistentRangeSet {
+	if set.ContainsRange(grange) {
+		return set
+	}
+
+	left, right := splitNodes(set.root, grange.Start)
+
+	if last := maxNode(left); last != nil && last.grange.End >= grange.Start {
+		grange.Start = last.grange.Start
+		grange.End = math.Max(grange.End, last.grange.End)
+		left = removeMaxNode(left)
+	}
+
+	overlapping, right := splitNodes(right, math.Nextafter(grange.End, math.Inf(1)))
+	if last := maxNode(overlapping); last != nil {
+		grange.End = math.Max(grange.End, last.grange.End)
+	}
+
+	return PersistentRangeSet{root: joinNodes(joinNodes(left, newPersistentNode(grange)), right)}
+}
+
+// Remove returns a version of the set that does not contain the values of grange
+func (set PersistentRangeSet) Remove(grange Range) PersistentRangeSet {
+	left, right := splitNodes(set.root, grange.Start)
+	overlapping, right := splitNodes(right, math.Nextafter(grange.End, math.Inf(1)))
+	remaining := RangeCollection{}
+
+	if last := maxNode(left); last != nil && last.grange.End >= grange.Start {
+		left = removeMaxNode(left)
+		remaining = append(remaining, Range{Start: last.grange.Start, End: math.Nextafter(grange.Start, math.Inf(-1))})
+		if last.grange.End > grange.End {
+			remaining = append(remaining, Range{Start: math.Nextafter(grange.End, math.Inf(1)), End: last.grange.End})
+		}
+	} else if overlapping == nil {
+		return set
+	}
+
+	if last := maxNode(overlapping); last != nil && last.grange.End > grange.End {
+		remaining = append(remaining, Range{Start: math.Nextafter(grange.End, math.Inf(1)), End: last.grange.End})
+	}
+
+	for _, piece := range remaining {
+		left = joinNodes(left, newPersistentNode(piece))
+	}
+
+	return PersistentRangeSet{root: joinNodes(left, right)}
+}
+
+// Contains tests if the set contains a given value
+func (set PersistentRangeSet) Contains(float float64) bool {
+	node := floorNode(set.root, float)
+	return node != nil && node.grange.Contains(float)
+}
+
+// ContainsRange tests if the set contains every value of a given Range
+func (set PersistentRangeSet) ContainsRange(grange Range) bool {
+	node := floorNode(set.root, grange.Start)
+	return node != nil && node.grange.End >= grange.End
+}
+
+// Len returns the number of merged Ranges in the set
+func (set PersistentRangeSet) Len() int {
+	return set.root.count()
+}
+
+// Collection returns the merged RangeCollection of values in the set
+func (set PersistentRangeSet) Collection() RangeCollection {
+	collection := make(RangeCollection, 0, set.Len())
+	set.root.each(func(grange Range) {
+		collection = append(collection, grange)
+	})
+
+	return collection
+}
+
+// Diff returns the values added and removed going from this version of the set to
+// another. Subtrees shared by both versions are skipped without being visited, so
+// diffing versions derived from one another costs time proportional to the number of
+// changes rather than the size of the sets.
+func (set PersistentRangeSet) Diff(other PersistentRangeSet) (added RangeCollection, removed RangeCollection) {
+	onlyOld, onlyNew := RangeCollection{}, RangeCollection{}
+	diffNodes(set.root, other.root, &onlyOld, &onlyNew)
+
+	return onlyNew.Subtract(onlyOld), onlyOld.Subtract(onlyNew)
+}
+
+// diffNodes collects the Ranges stored in only one of two trees
+func diffNodes(older *persistentNode, newer *persistentNode, onlyOld *RangeCollection, onlyNew *RangeCollection) {
+	if older == newer {
+		return
+	} else if older == nil {
+		newer.each(func(grange Range) { *onlyNew = append(*onlyNew, grange) })
+		return
+	} else if newer == nil {
+		older.each(func(grange Range) { *onlyOld = append(*onlyOld, grange) })
+		return
+	}
+
+	left, right := splitNodes(older, newer.grange.Start)
+	diffNodes(left, newer.left, onlyOld, onlyNew)
+
+	if first := minNode(right); first != nil && first.grange == newer.grange {
+		right = removeMinNode(right)
+	} else {
+		*onlyNew = append(*onlyNew, newer.grange)
+	}
+
+	diffNodes(right, newer.right, onlyOld, onlyNew)
+}
+
+func newPersistentNode(grange Range) *persistentNode {
+	// splitmix64 of the start spreads priorities evenly regardless of the inserted values
+	priority := math.Float64bits(grange.Start) + 0x9e3779b97f4a7c15
+	priority = (priority ^ (priority >> 30)) * 0xbf58476d1ce4e5b9
+	priority = (priority ^ (priority >> 27)) * 0x94d049bb133111eb
+	priority ^= priority >> 31
+
+	return &persistentNode{grange: grange, priority: priority, size: 1}
+}
+
+func (n *persistentNode) count() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func (n *persistentNode) each(fn func(Range)) {
+	if n == nil {
+		return
+	}
+
+	n.left.each(fn)
+	fn(n.grange)
+	n.right.each(fn)
+}
+
+// with returns n with new children, copying n only if the children have changed
+func (n *persistentNode) with(left *persistentNode, right *persistentNode) *persistentNode {
+	if left == n.left && right == n.right {
+		return n
+	}
+
+	return &persistentNode{
+		grange:   n.grange,
+		priority: n.priority,
+		size:     left.count() + right.count() + 1,
+		left:     left,
+		right:    right,
+	}
+}
+
+// splitNodes splits a tree into the Ranges that start before key and the rest
+func splitNodes(n *persistentNode, key float64) (*persistentNode, *persistentNode) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if n.grange.Start < key {
+		left, right := splitNodes(n.right, key)
+		return n.with(n.left, left), right
+	}
+
+	left, right := splitNodes(n.left, key)
+	return left, n.with(right, n.right)
+}
+
+// joinNodes joins two trees where every Range in left starts before every Range in right
+func joinNodes(left *persistentNode, right *persistentNode) *persistentNode {
+	if left == nil {
+		return right
+	} else if right == nil {
+		return left
+	}
+
+	if left.priority > right.priority {
+		return left.with(left.left, joinNodes(left.right, right))
+	}
+
+	return right.with(joinNodes(left, right.left), right.right)
+}
+
+func floorNode(n *persistentNode, key float64) *persistentNode {
+	var floor *persistentNode
+
+	for n != nil {
+		if n.grange.Start <= key {
+			floor, n = n, n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return floor
+}
+
+func minNode(n *persistentNode) *persistentNode {
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+func maxNode(n *persistentNode) *persistentNode {
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+
+	return n
+}
+
+func removeMinNode(n *persistentNode) *persistentNode {
+	if n.left == nil {
+		return n.right
+	}
+
+	return n.with(removeMinNode(n.left), n.right)
+}
+
+func removeMaxNode(n *persistentNode) *persistentNode {
+	if n.right == nil {
+		return n.left
+	}
+
+	return n.with(n.left, removeMaxNode(n.right))
+}
@@ -0,0 +1,110 @@
+package gorange
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func (n *persistentNode) depth() int {
+	if n == nil {
+		return 0
+	}
+
+	left, right := n.left.depth(), n.right.depth()
+	if left > right {
+		return left + 1
+	}
+
+	return right + 1
+}
+
+// PERSISTENT SETS:
+// Adds and removes ranges
+func TestPersistentRangeSet(t *testing.T) {
+	set := NewPersistentRangeSet(Range{Start: 20, End: 30}, Range{Start: 0, End: 10})
+	set = set.Add(Range{Start: 8, End: 12}).Add(Range{Start: 40, End: math.Inf(1)}).Remove(Range{Start: 25, End: 45})
+
+	expectedCollection := RangeCollection{
+		Range{Start: 0, End: 12},
+		Range{Start: 20, End: math.Nextafter(25, math.Inf(-1))},
+		Range{Start: math.Nextafter(45, math.Inf(1)), End: math.Inf(1)},
+	}
+
+	if collection := set.Collection(); !collection.Equal(expectedCollection) || set.Len() != 3 {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, collection)
+	}
+
+	if !set.Contains(12) || set.Contains(15) || set.Contains(45) || !set.Contains(1e9) {
+		t.Errorf("Failed! Contains did not match %v", set.Collection())
+	}
+}
+
+// Keeps old versions valid and shares unchanged versions
+func TestPersistentRangeSetVersions(t *testing.T) {
+	first := NewPersistentRangeSet(Range{Start: 0, End: 10})
+	second := first.Add(Range{Start: 20, End: 30})
+	third := second.Remove(Range{Start: 5, End: 25})
+
+	if !first.Collection().Equal(RangeCollection{Range{Start: 0, End: 10}}) || second.Len() != 2 || third.Len() != 2 {
+		t.Errorf("Failed! Versions were modified: %v, %v, %v", first.Collection(), second.Collection(), third.Collection())
+	}
+
+	if second.Add(Range{Start: 2, End: 3}).root != second.root || second.Remove(Range{Start: 12, End: 18}).root != second.root {
+		t.Errorf("Failed! Unchanged versions should share their root")
+	}
+}
+
+// Matches RangeCollection operations and stays balanced
+func TestPersistentRangeSetRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	set, collection := PersistentRangeSet{}, RangeCollection{}
+
+	for i := 0; i < 5000; i++ {
+		start := float64(random.Intn(100000))
+		grange := Range{Start: start, End: start + float64(random.Intn(20))}
+
+		if random.Intn(4) == 0 {
+			set, collection = set.Remove(grange), collection.Subtract(RangeCollection{grange})
+		} else {
+			set, collection = set.Add(grange), collection.Union(RangeCollection{grange})
+		}
+	}
+
+	if !set.Collection().Equal(collection) {
+		t.Errorf("Failed! Persistent set did not match RangeCollection")
+	}
+
+	if depth := set.root.depth(); depth > 4*int(math.Log2(float64(set.Len()))) {
+		t.Errorf("Failed! Tree of %d ranges is unbalanced with depth %d", set.Len(), depth)
+	}
+}
+
+// DIFFING:
+// Diffs two versions
+func TestPersistentRangeSetDiff(t *testing.T) {
+	random := rand.New(rand.NewSource(2))
+	base := PersistentRangeSet{}
+	for i := 0; i < 2000; i++ {
+		start := float64(random.Intn(100000))
+		base = base.Add(Range{Start: start, End: start + 5})
+	}
+
+	changed := base.Add(Range{Start: -10, End: -5}).Remove(Range{Start: 50000, End: 60000}).Add(Range{Start: 200000, End: math.Inf(1)})
+
+	added, removed := base.Diff(changed)
+	expectedAdded := changed.Collection().Subtract(base.Collection())
+	expectedRemoved := base.Collection().Subtract(changed.Collection())
+
+	if !added.Equal(expectedAdded) || !removed.Equal(expectedRemoved) {
+		t.Errorf("Failed! Expected added %v and removed %v, Got: %v and %v", expectedAdded, expectedRemoved, added, removed)
+	}
+
+	if added, removed := base.Diff(base); len(added) != 0 || len(removed) != 0 {
+		t.Errorf("Failed! Expected no differences, Got: %v and %v", added, removed)
+	}
+
+	if added, _ := (PersistentRangeSet{}).Diff(base); !added.Equal(base.Collection()) {
+		t.Errorf("Failed! Expected everything to be added")
+	}
+}
@@ -0,0 +1,321 @@
+package gorange
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrEmptyRange is returned when parsing a PostgreSQL range that contains no values,
+// which a Range cannot represent
+var ErrEmptyRange = errors.New("Range is empty")
+
+// ParsePostgresRange parses a PostgreSQL range literal such as "[1,5)", "(,10]" or
+// "empty", using parser to parse its bounds. Since Ranges are closed, an excluded bound
+// is stored as the nearest representable value inside it. It will return ErrEmptyRange
+// if the range contains no values.
+func ParsePostgresRange(literal string, parser ValueParser) (Range, error) {
+	literal = strings.TrimSpace(literal)
+
+	if strings.EqualFold(literal, "empty") {
+		return Range{}, ErrEmptyRange
+	}
+
+	if len(literal) < 3 || !strings.ContainsRune("[(", rune(literal[0])) || !strings.ContainsRune("])", rune(literal[len(literal)-1])) {
+		return Range{}, postgresError(literal, errors.New("missing bounds"))
+	}
+
+	bounds, err := splitPostgresList(literal[1 : len(literal)-1])
+	if err != nil || len(bounds) != 2 {
+		return Range{}, postgresError(literal, errors.New("expected two bounds"))
+	}
+
+	start, err := parsePostgresBound(bounds[0], math.Inf(-1), parser)
+	if err != nil {
+		return Range{}, postgresError(literal, err)
+	}
+
+	end, err := parsePostgresBound(bounds[1], math.Inf(1), parser)
+	if err != nil {
+		return Range{}, postgresError(literal, err)
+	}
+
+	if start > end {
+		return Range{}, postgresError(literal, errors.New("lower bound is after upper bound"))
+	}
+
+	if literal[0] == '(' && start != math.Inf(-1) {
+		start = math.Nextafter(start, math.Inf(1))
+	}
+
+	if literal[len(literal)-1] == ')' && end != math.Inf(1) {
+		end = math.Nextafter(end, math.Inf(-1))
+	}
+
+	if start > end {
+		return Range{}, ErrEmptyRange
+	}
+
+	return NewRange(start, end)
+}
+
+// FormatPostgresRange renders a range as a PostgreSQL range literal, using formatter to
+// render its bounds. A bound one representable value inside an integer or a value that
+// renders exactly, such as one produced by parsing an excluded bound, is rendered as that
+// excluded value.
+func FormatPostgresRange(r Range, formatter ValueFormatter) string {
+	lower, start := "[", ""
+	if r.Start == math.Inf(-1) {
+		lower = "("
+	} else if bound, ok := excludedPostgresBound(r.Start, math.Inf(-1), formatter); ok {
+		lower, start = "(", bound
+	} else {
+		start = formatter(r.Start)
+	}
+
+	upper, end := "]", ""
+	if r.End == math.Inf(1) {
+		upper = ")"
+	} else if bound, ok := excludedPostgresBound(r.End, math.Inf(1), formatter); ok {
+		upper, end = ")", bound
+	} else {
+		end = formatter(r.End)
+	}
+
+	return lower + quotePostgresBound(start) + "," + quotePostgresBound(end) + upper
+}
+
+// ParsePostgresMultirange parses a PostgreSQL multirange literal such as "{[1,3),[5,7]}",
+// using parser to parse the bounds of each range. Empty ranges are omitted.
+func ParsePostgresMultirange(literal string, parser ValueParser) (RangeCollection, error) {
+	literal = strings.TrimSpace(literal)
+
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return RangeCollection{}, postgresError(literal, errors.New("missing braces"))
+	}
+
+	collection := RangeCollection{}
+	if strings.TrimSpace(literal[1:len(literal)-1]) == "" {
+		return collection, nil
+	}
+
+	ranges, err := splitPostgresList(literal[1 : len(literal)-1])
+	if err != nil {
+		return RangeCollection{}, postgresError(literal, err)
+	}
+
+	for _, srange := range ranges {
+		grange, err := ParsePostgresRange(srange, parser)
+		if errors.Is(err, ErrEmptyRange) {
+			continue
+		} else if err != nil {
+			return RangeCollection{}, err
+		}
+		collection = append(collection, grange)
+	}
+
+	return collection, nil
+}
+
+// FormatPostgresMultirange renders a RangeCollection as a PostgreSQL multirange literal,
+// using formatter to render the bounds of each Range
+func FormatPostgresMultirange(collection RangeCollection, formatter ValueFormatter) string {
+	ranges := []string{}
+
+	for _, grange := range collection {
+		ranges = append(ranges, FormatPostgresRange(grange, formatter))
+	}
+
+	return "{" + strings.Join(ranges, ",") + "}"
+}
+
+// Scan implements sql.Scanner for PostgreSQL range columns. Numeric bounds are parsed
+// as numbers, and timestamp and date bounds are parsed as Unix seconds.
+func (r *Range) Scan(src interface{}) error {
+	literal, err := postgresSource(src)
+	if err != nil {
+		return err
+	}
+
+	grange, err := ParsePostgresRange(literal, parsePostgresValue)
+	if err != nil {
+		return err
+	}
+
+	*r = grange
+	return nil
+}
+
+// Value implements driver.Valuer, writing a range as a numeric PostgreSQL range literal.
+// Use TimestampRange for timestamp range columns.
+func (r Range) Value() (driver.Value, error) {
+	return FormatPostgresRange(r, FormatFloat), nil
+}
+
+// Scan implements sql.Scanner for PostgreSQL multirange columns. Range columns are also
+// accepted, with "empty" scanned as an empty RangeCollection.
+func (collection *RangeCollection) Scan(src interface{}) error {
+	literal, err := postgresSource(src)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(literal), "{") {
+		*collection, err = ParsePostgresMultirange(literal, parsePostgresValue)
+		return err
+	}
+
+	grange, err := ParsePostgresRange(literal, parsePostgresValue)
+	if errors.Is(err, ErrEmptyRange) {
+		*collection = RangeCollection{}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	*collection = RangeCollection{grange}
+	return nil
+}
+
+// Value implements driver.Valuer, writing a RangeCollection as a numeric PostgreSQL
+// multirange literal. Use TimestampRangeCollection for timestamp multirange columns.
+func (collection RangeCollection) Value() (driver.Value, error) {
+	return FormatPostgresMultirange(collection, FormatFloat), nil
+}
+
+// TimestampRange is a Range of Unix seconds for tstzrange and tsrange columns, which
+// reject the numeric bounds written by Range
+type TimestampRange Range
+
+// Scan implements sql.Scanner for PostgreSQL timestamp range columns
+func (r *TimestampRange) Scan(src interface{}) error {
+	return (*Range)(r).Scan(src)
+}
+
+// Value implements driver.Valuer, writing a range as a PostgreSQL range literal with
+// timestamp bounds
+func (r TimestampRange) Value() (driver.Value, error) {
+	return FormatPostgresRange(Range(r), FormatTimestamp), nil
+}
+
+// TimestampRangeCollection is a RangeCollection of Unix seconds for tstzmultirange and
+// tsmultirange columns
+type TimestampRangeCollection RangeCollection
+
+// Scan implements sql.Scanner for PostgreSQL timestamp multirange and range columns
+func (collection *TimestampRangeCollection) Scan(src interface{}) error {
+	return (*RangeCollection)(collection).Scan(src)
+}
+
+// Value implements driver.Valuer, writing a RangeCollection as a PostgreSQL multirange
+// literal with timestamp bounds
+func (collection TimestampRangeCollection) Value() (driver.Value, error) {
+	return FormatPostgresMultirange(RangeCollection(collection), FormatTimestamp), nil
+}
+
+func postgresSource(src interface{}) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	default:
+		return "", errors.New(fmt.Sprintf("Cannot scan %T into a range", src))
+	}
+}
+
+func parsePostgresValue(svalue string) (float64, error) {
+	float, err := ParseFloat(svalue)
+	if err != nil {
+		return ParseTimestamp(svalue)
+	}
+
+	return float, nil
+}
+
+// excludedPostgresBound renders the neighbouring representable value of a bound in the
+// direction of toward if the bound is better written as that value excluded. That is the
+// case when the neighbour is an integer, or when it renders exactly while the bound does
+// not, as with timestamps rounded to microseconds, or renders shorter.
+func excludedPostgresBound(bound float64, toward float64, formatter ValueFormatter) (string, bool) {
+	if adjacent, ok := adjacentInteger(bound, toward); ok {
+		return formatter(adjacent), true
+	}
+
+	adjacent := math.Nextafter(bound, toward)
+	if math.IsInf(adjacent, 0) {
+		return "", false
+	}
+
+	sadjacent, sbound := formatter(adjacent), formatter(bound)
+	if parsed, err := parsePostgresValue(sadjacent); err != nil || parsed != adjacent {
+		return "", false
+	}
+
+	parsed, err := parsePostgresValue(sbound)
+	return sadjacent, err != nil || parsed != bound || len(sadjacent) < len(sbound)
+}
+
+func parsePostgresBound(sbound string, open float64, parser ValueParser) (float64, error) {
+	if sbound == "" {
+		return open, nil
+	}
+
+	if strings.HasPrefix(sbound, "\"") {
+		if len(sbound) < 2 || !strings.HasSuffix(sbound, "\"") {
+			return 0, errors.New(fmt.Sprintf("unterminated quote in (%s)", sbound))
+		}
+		sbound = sbound[1 : len(sbound)-1]
+		sbound = strings.ReplaceAll(sbound, "\"\"", "\"")
+		sbound = strings.ReplaceAll(sbound, "\\\"", "\"")
+		sbound = strings.ReplaceAll(sbound, "\\\\", "\\")
+	}
+
+	return parser(sbound)
+}
+
+func quotePostgresBound(sbound string) string {
+	if !strings.ContainsAny(sbound, " ,()[]{}\"\\") {
+		return sbound
+	}
+
+	sbound = strings.ReplaceAll(sbound, "\\", "\\\\")
+	sbound = strings.ReplaceAll(sbound, "\"", "\\\"")
+	return "\"" + sbound + "\""
+}
+
+// splitPostgresList splits a comma separated list, ignoring commas within quotes or
+// brackets
+func splitPostgresList(list string) ([]string, error) {
+	items := []string{}
+	depth, quoted, start := 0, false, 0
+
+	for i := 0; i < len(list); i++ {
+		switch {
+		case list[i] == '\\' && quoted:
+			i++
+		case list[i] == '"':
+			quoted = !quoted
+		case quoted:
+		case list[i] == '[' || list[i] == '(':
+			depth++
+		case list[i] == ']' || list[i] == ')':
+			depth--
+		case list[i] == ',' && depth == 0:
+			items = append(items, strings.TrimSpace(list[start:i]))
+			start = i + 1
+		}
+	}
+
+	if quoted || depth != 0 {
+		return items, errors.New(fmt.Sprintf("unbalanced list (%s)", list))
+	}
+
+	return append(items, strings.TrimSpace(list[start:])), nil
+}
+
+func postgresError(literal string, err error) error {
+	return errors.New(fmt.Sprintf("Error parsing PostgreSQL range (%s): %v", literal, err))
+}
@@ -0,0 +1,218 @@
+package gorange
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = &Range{}
+	_ driver.Valuer = Range{}
+	_ sql.Scanner   = &RangeCollection{}
+	_ driver.Valuer = RangeCollection{}
+)
+
+// PARSING:
+// Parses PostgreSQL range literals
+func TestParsePostgresRange(t *testing.T) {
+	literals := map[string]Range{
+		"[1,5]":                {Start: 1, End: 5},
+		"[1,5)":                {Start: 1, End: math.Nextafter(5, math.Inf(-1))},
+		"(1,5]":                {Start: math.Nextafter(1, math.Inf(1)), End: 5},
+		"(,10]":                {Start: math.Inf(-1), End: 10},
+		"[-2.5,)":              {Start: -2.5, End: math.Inf(1)},
+		"(,)":                  {Start: math.Inf(-1), End: math.Inf(1)},
+		"[\"1\",\"2\"]":        {Start: 1, End: 2},
+		"[-infinity,infinity]": {Start: math.Inf(-1), End: math.Inf(1)},
+	}
+
+	for literal, expectedRange := range literals {
+		grange, err := ParsePostgresRange(literal, ParseFloat)
+
+		if err != nil || grange != expectedRange {
+			t.Errorf("Failed! Literal: %s, Expected: %v, Got: %v, Error: %v", literal, expectedRange, grange, err)
+		}
+	}
+}
+
+// Parses empty PostgreSQL ranges
+func TestParseEmptyPostgresRange(t *testing.T) {
+	for _, literal := range []string{"empty", "EMPTY", "[1,1)", "(1,1]"} {
+		grange, err := ParsePostgresRange(literal, ParseFloat)
+
+		if !errors.Is(err, ErrEmptyRange) {
+			t.Errorf("Failed! Literal: %s, Expected empty range, Got: %v, Error: %v", literal, grange, err)
+		}
+	}
+}
+
+// Fails to parse invalid PostgreSQL ranges
+func TestParseInvalidPostgresRange(t *testing.T) {
+	for _, literal := range []string{"", "1,5", "[1,5", "[1]", "[1,2,3]", "[a,5]", "[\"1,5]", "[5,1]"} {
+		grange, err := ParsePostgresRange(literal, ParseFloat)
+
+		if err == nil || errors.Is(err, ErrEmptyRange) {
+			t.Errorf("Failed! Literal: %s, Expected failure with: %v", literal, grange)
+		}
+	}
+}
+
+// Parses PostgreSQL multirange literals
+func TestParsePostgresMultirange(t *testing.T) {
+	expectedCollection := RangeCollection{Range{Start: 1, End: math.Nextafter(3, math.Inf(-1))}, Range{Start: 5, End: math.Inf(1)}}
+	collection, err := ParsePostgresMultirange("{[1,3), empty, [5,)}", ParseFloat)
+
+	if err != nil || !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", expectedCollection, collection, err)
+	}
+
+	collection, err = ParsePostgresMultirange("{}", ParseFloat)
+	if err != nil || len(collection) != 0 {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", RangeCollection{}, collection, err)
+	}
+}
+
+// FORMATTING:
+// Formats PostgreSQL range literals
+func TestFormatPostgresRange(t *testing.T) {
+	literals := []string{"[1,5]", "[1,5)", "(1,5]", "(,10]", "[-2.5,)", "(,)", "(1,2)"}
+
+	for _, literal := range literals {
+		grange, _ := ParsePostgresRange(literal, ParseFloat)
+
+		if formatted := FormatPostgresRange(grange, FormatFloat); formatted != literal {
+			t.Errorf("Failed! Expected: %s, Got: %s", literal, formatted)
+		}
+	}
+}
+
+// Formats quoted timestamp bounds
+func TestFormatPostgresTimestampRange(t *testing.T) {
+	literal := "[\"2024-01-01 00:00:00+00\",\"2024-02-01 00:00:00+00\")"
+	expected := "[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z)"
+
+	grange, err := ParsePostgresRange(literal, ParseTimestamp)
+	formatted := FormatPostgresRange(grange, FormatTimestamp)
+
+	if err != nil || formatted != expected {
+		t.Errorf("Failed! Expected: %s, Got: %s, Error: %v", expected, formatted, err)
+	}
+
+	quoted := FormatPostgresRange(Range{Start: 1, End: 2}, func(float float64) string { return "a \"b\"" })
+	if quoted != "[\"a \\\"b\\\"\",\"a \\\"b\\\"\"]" {
+		t.Errorf("Failed! Bounds were not quoted: %s", quoted)
+	}
+}
+
+// SCANNING:
+// Scans and values ranges
+func TestScanRange(t *testing.T) {
+	var grange Range
+
+	if err := grange.Scan([]byte("[1,10)")); err != nil || grange != (Range{Start: 1, End: math.Nextafter(10, math.Inf(-1))}) {
+		t.Errorf("Failed! Got: %v, Error: %v", grange, err)
+	}
+
+	if value, err := grange.Value(); err != nil || value != "[1,10)" {
+		t.Errorf("Failed! Expected: [1,10), Got: %v, Error: %v", value, err)
+	}
+
+	if err := grange.Scan("[\"2024-01-01\",\"2024-01-02\")"); err != nil || grange.Start != 1704067200 {
+		t.Errorf("Failed! Got: %v, Error: %v", grange, err)
+	}
+
+	if err := grange.Scan(nil); err == nil {
+		t.Errorf("Failed! Expected failure scanning NULL")
+	}
+
+	if err := grange.Scan("empty"); !errors.Is(err, ErrEmptyRange) {
+		t.Errorf("Failed! Expected empty range error, Got: %v", err)
+	}
+}
+
+// Scans and values RangeCollections
+func TestScanRangeCollection(t *testing.T) {
+	var collection RangeCollection
+
+	if err := collection.Scan("{[1,2],[4,)}"); err != nil || !collection.Equal(RangeCollection{Range{Start: 1, End: 2}, Range{Start: 4, End: math.Inf(1)}}) {
+		t.Errorf("Failed! Got: %v, Error: %v", collection, err)
+	}
+
+	if value, err := collection.Value(); err != nil || value != "{[1,2],[4,)}" {
+		t.Errorf("Failed! Expected: {[1,2],[4,)}, Got: %v, Error: %v", value, err)
+	}
+
+	if err := collection.Scan("(,0]"); err != nil || !collection.Equal(RangeCollection{Range{Start: math.Inf(-1), End: 0}}) {
+		t.Errorf("Failed! Got: %v, Error: %v", collection, err)
+	}
+
+	if err := collection.Scan("empty"); err != nil || len(collection) != 0 {
+		t.Errorf("Failed! Got: %v, Error: %v", collection, err)
+	}
+}
+
+// Scans and values timestamp ranges
+func TestScanTimestampRange(t *testing.T) {
+	grange := TimestampRange{Start: 1704067200, End: math.Nextafter(1706745600, math.Inf(-1))}
+	expected := "[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z)"
+
+	value, err := grange.Value()
+	if err != nil || value != expected {
+		t.Errorf("Failed! Expected: %s, Got: %v, Error: %v", expected, value, err)
+	}
+
+	var scanned TimestampRange
+	if err := scanned.Scan(value); err != nil || scanned != grange {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", grange, scanned, err)
+	}
+
+	if err := scanned.Scan("[\"2024-01-01 00:00:00+00\",\"2024-02-01 00:00:00+00\")"); err != nil || scanned != grange {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", grange, scanned, err)
+	}
+}
+
+// Keeps non-integer excluded bounds excluded when scanning and valuing
+func TestScanExcludedBounds(t *testing.T) {
+	for _, literal := range []string{"[1.5,2.5)", "(1.5,2.5]", "(-0.1,0.3)", "[1,5]", "[-2.5,)"} {
+		var grange Range
+		if err := grange.Scan(literal); err != nil {
+			t.Errorf("Failed! Literal: %s, Error: %v", literal, err)
+		} else if value, _ := grange.Value(); value != literal {
+			t.Errorf("Failed! Expected: %s, Got: %v", literal, value)
+		}
+	}
+
+	var grange TimestampRange
+	literal := "[\"2024-01-02 15:04:05.5+00\",\"2024-01-03 00:00:00.25+00\")"
+	expected := "[2024-01-02T15:04:05.5Z,2024-01-03T00:00:00.25Z)"
+
+	if err := grange.Scan(literal); err != nil {
+		t.Errorf("Failed! Literal: %s, Error: %v", literal, err)
+	} else if value, _ := grange.Value(); value != expected {
+		t.Errorf("Failed! Expected: %s, Got: %v", expected, value)
+	}
+
+	inclusive := TimestampRange{Start: 1704207845.5, End: 1704240000.25}
+	if value, _ := inclusive.Value(); value != "[2024-01-02T15:04:05.5Z,2024-01-03T00:00:00.25Z]" {
+		t.Errorf("Failed! Expected an inclusive range, Got: %v", value)
+	}
+}
+
+// Scans and values timestamp RangeCollections
+func TestScanTimestampRangeCollection(t *testing.T) {
+	collection := TimestampRangeCollection{Range{Start: 1704067200, End: 1704153600}, Range{Start: 1706745600.5, End: math.Inf(1)}}
+	expected := "{[2024-01-01T00:00:00Z,2024-01-02T00:00:00Z],[2024-02-01T00:00:00.5Z,)}"
+
+	value, err := collection.Value()
+	if err != nil || value != expected {
+		t.Errorf("Failed! Expected: %s, Got: %v, Error: %v", expected, value, err)
+	}
+
+	var scanned TimestampRangeCollection
+	if err := scanned.Scan(value); err != nil || !RangeCollection(scanned).Equal(RangeCollection(collection)) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", collection, scanned, err)
+	}
+}
@@ -21,7 +21,13 @@ func (r Range) Equal(other Range) bool {
 
 // Overlap tests if the values of one range overlap the values of another
 func (r Range) Overlap(other Range) bool {
-	return r.End >= other.Start || other.Start <= r.End
+	return r.Start <= other.End && other.Start <= r.End
+}
+
+// touches tests if two ranges overlap or are separated by no representable value, so
+// that together they cover a single continuous range
+func (r Range) touches(other Range) bool {
+	return r.Start <= math.Nextafter(other.End, math.Inf(1)) && other.Start <= math.Nextafter(r.End, math.Inf(1))
 }
 
 // Infinite tests if a range is infinite in both directions
@@ -49,6 +55,36 @@ func (r Range) Merge(other Range) (Range, error) {
 	}
 }
 
+// Intersect returns the range of values shared by one range and another.
+// It will return an error if the ranges do not overlap
+func (r Range) Intersect(other Range) (Range, error) {
+	if !r.Overlap(other) {
+		return r, errors.New(fmt.Sprintf("Range %v does not overlap range %v", r, other))
+	}
+
+	return NewRange(math.Max(r.Start, other.Start), math.Min(r.End, other.End))
+}
+
+// Format renders a range in the form accepted by ParseRange using the supplied delimiter
+func (r Range) Format(delimiter string) string {
+	return r.FormatWith(delimiter, FormatFloat)
+}
+
+// FormatWith renders a range like Format, using formatter to render the bounds of the range
+func (r Range) FormatWith(delimiter string, formatter ValueFormatter) string {
+	if r.Infinite() {
+		return delimiter
+	} else if r.Start == math.Inf(-1) {
+		return delimiter + formatter(r.End)
+	} else if r.End == math.Inf(1) {
+		return formatter(r.Start) + delimiter
+	} else if r.Start == r.End {
+		return formatter(r.Start)
+	}
+
+	return formatter(r.Start) + delimiter + formatter(r.End)
+}
+
 func (r Range) values(fn func(float64) float64) []float64 {
 	valueList := []float64{}
 
@@ -156,77 +192,74 @@ func NewRange(start float64, end float64) (Range, error) {
 	return Range{Start: start, End: end}, nil
 }
 
+// ValueParser parses a single bound of a range from a string
+type ValueParser func(string) (float64, error)
+
+// ValueFormatter renders a single bound of a range as a string
+type ValueFormatter func(float64) string
+
 // ParseRange parses a range from a string. If the range is not in one of these forms
 // (assuming the delimiter to be ":"), [":", "Num:", ":Num", "Num:Num"], ParseRange
 // will return an error.
 func ParseRange(srange string, delimiter string) (Range, error) {
-	var prange Range
-
-	if strings.Contains(srange, delimiter) {
-		index := strings.Index(srange, delimiter)
+	return ParseRangeWith(srange, delimiter, ParseFloat)
+}
 
-		if srange == delimiter {
-			return Range{Start: math.Inf(-1), End: math.Inf(1)}, nil
+// ParseRangeWith parses a range from a string like ParseRange, using parser to parse
+// the bounds of the range instead of strconv.ParseFloat
+func ParseRangeWith(srange string, delimiter string, parser ValueParser) (Range, error) {
+	index := strings.Index(srange, delimiter)
+	if delimiter == "" || index < 0 {
+		float, err := parser(srange)
+		if err != nil {
+			return Range{}, parsingError(srange, delimiter, err)
 		}
 
-		var float float64
-		var err error = nil
-		switch index {
-		case 0:
-			float, err = strconv.ParseFloat(srange[1:], 64)
-
-			if err != nil {
-				return prange, parsingError(srange, delimiter, err)
-			}
-
-			var err error = nil
-			prange, err = NewRange(math.Inf(-1), float)
-			if err != nil {
-				return prange, err
-			}
-		case len(srange) - 1:
-			float, err = strconv.ParseFloat(srange[:len(srange)-1], 64)
-
-			if err != nil {
-				return prange, parsingError(srange, delimiter, err)
-			}
-
-			var err error = nil
-			prange, err = NewRange(float, math.Inf(1))
-			if err != nil {
-				return prange, err
-			}
-		default:
-			ends := strings.Split(srange, delimiter)
+		return NewRange(float, float)
+	}
 
-			if len(ends) != 2 {
-				return prange, parsingError(srange, delimiter, err)
-			}
+	if srange == delimiter {
+		return Range{Start: math.Inf(-1), End: math.Inf(1)}, nil
+	}
 
-			start, err := strconv.ParseFloat(ends[0], 64)
-			end, err := strconv.ParseFloat(ends[1], 64)
+	start, end := math.Inf(-1), math.Inf(1)
+	sstart, send := srange[:index], srange[index+len(delimiter):]
 
-			if err != nil {
-				return prange, parsingError(srange, delimiter, err)
-			}
+	if strings.Contains(send, delimiter) {
+		return Range{}, parsingError(srange, delimiter, errors.New("too many delimiters"))
+	}
 
-			err = nil
-			prange, err = NewRange(start, end)
-			if err != nil {
-				return prange, err
-			}
+	var err error
+	if sstart != "" {
+		if start, err = parser(sstart); err != nil {
+			return Range{}, parsingError(srange, delimiter, err)
 		}
-	} else {
-		float, err := strconv.ParseFloat(srange, 64)
-		if err != nil {
-			return prange, parsingError(srange, delimiter, err)
+	}
+	if send != "" {
+		if end, err = parser(send); err != nil {
+			return Range{}, parsingError(srange, delimiter, err)
 		}
-
-		err = nil
-		prange, err = NewRange(float, float)
 	}
 
-	return prange, nil
+	return NewRange(start, end)
+}
+
+// ParseFloat is the ValueParser used by ParseRange. It parses plain decimal numbers.
+func ParseFloat(svalue string) (float64, error) {
+	return strconv.ParseFloat(svalue, 64)
+}
+
+// FormatFloat is the ValueFormatter used by Range.Format. It renders plain decimal numbers.
+func FormatFloat(float float64) string {
+	return strconv.FormatFloat(float, 'f', -1, 64)
+}
+
+// adjacentInteger returns the neighbouring representable value of a non-integer float in
+// the direction of toward, if that value is an integer. This is how the excluded bound
+// of a half-open range is recovered from a closed Range.
+func adjacentInteger(float float64, toward float64) (float64, bool) {
+	adjacent := math.Nextafter(float, toward)
+	return adjacent, adjacent == math.Trunc(adjacent) && float != math.Trunc(float)
 }
 
 func parsingError(srange string, delimiter string, err error) error {
@@ -53,6 +53,17 @@ func (collection RangeCollection) IsMerged() bool {
 	return true
 }
 
+// Contains tests if any Range in a RangeCollection contains a given value
+func (collection RangeCollection) Contains(float float64) bool {
+	for _, grange := range collection {
+		if grange.Contains(float) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Values returns all values represented by the Ranges in a RangeCollection
 func (collection RangeCollection) Values() []float64 {
 	if !collection.IsMerged() {
@@ -130,6 +141,125 @@ func (collection RangeCollection) Merge() RangeCollection {
 	return newCollection
 }
 
+// MergeWithTolerance merges the Ranges in this RangeCollection like Merge, also merging
+// Ranges separated by a gap no larger than gap, so that 1:5, 6:9 and 11:20 merged with a
+// tolerance of 2 become 1:20. Unlike Merge, the RangeCollection is not reordered.
+func (collection RangeCollection) MergeWithTolerance(gap float64) RangeCollection {
+	return collection.mergeAdjacent(func(last Range, next Range) bool {
+		return next.Start-last.End <= gap
+	})
+}
+
+// MergeIntegers merges the Ranges in this RangeCollection for a domain of integers, where
+// Ranges are adjacent when no integer lies between them, so that 1:5 and 6:9 become 1:9
+// but 1:5.5 and 6.5:7 are kept apart by 6. Unlike Merge, the RangeCollection is not
+// reordered.
+func (collection RangeCollection) MergeIntegers() RangeCollection {
+	return collection.mergeAdjacent(func(last Range, next Range) bool {
+		return math.Floor(last.End)+1 >= math.Ceil(next.Start)
+	})
+}
+
+// mergeAdjacent merges overlapping Ranges in a sorted copy of this RangeCollection, along
+// with Ranges that adjacent reports as adjacent to the Range before them
+func (collection RangeCollection) mergeAdjacent(adjacent func(last Range, next Range) bool) RangeCollection {
+	sorted := NewRangeCollection(collection)
+	sort.Sort(sorted)
+
+	merged := RangeCollection{}
+	for _, grange := range sorted {
+		if last := len(merged) - 1; last >= 0 && (merged[last].Overlap(grange) || adjacent(merged[last], grange)) {
+			merged[last].End = math.Max(merged[last].End, grange.End)
+		} else {
+			merged = append(merged, grange)
+		}
+	}
+
+	return merged
+}
+
+// Union returns a merged RangeCollection containing the values of both RangeCollections
+func (collection RangeCollection) Union(other RangeCollection) RangeCollection {
+	union := RangeCollection{}
+	union = append(union, collection...)
+	union = append(union, other...)
+
+	return union.Merge()
+}
+
+// Intersect returns a merged RangeCollection containing the values shared by both
+// RangeCollections
+func (collection RangeCollection) Intersect(other RangeCollection) RangeCollection {
+	left := NewRangeCollection(collection).Merge()
+	right := NewRangeCollection(other).Merge()
+
+	intersection := RangeCollection{}
+
+	for i, j := 0, 0; i < len(left) && j < len(right); {
+		if grange, err := left[i].Intersect(right[j]); err == nil {
+			intersection = append(intersection, grange)
+		}
+
+		if left[i].End < right[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return intersection
+}
+
+// Subtract returns a merged RangeCollection containing the values of this RangeCollection
+// that are not contained in the other RangeCollection
+func (collection RangeCollection) Subtract(other RangeCollection) RangeCollection {
+	return collection.Intersect(other.Complement())
+}
+
+// Complement returns a merged RangeCollection containing every value not contained in
+// this RangeCollection. Since Ranges are closed, the bounds of each gap are the nearest
+// representable values to the neighbouring Ranges.
+func (collection RangeCollection) Complement() RangeCollection {
+	merged := NewRangeCollection(collection).Merge()
+
+	complement := RangeCollection{}
+	start := math.Inf(-1)
+
+	for _, grange := range merged {
+		if grange.Start != math.Inf(-1) {
+			if gap, err := NewRange(start, math.Nextafter(grange.Start, math.Inf(-1))); err == nil {
+				complement = append(complement, gap)
+			}
+		}
+
+		if grange.End == math.Inf(1) {
+			return complement
+		}
+
+		start = math.Nextafter(grange.End, math.Inf(1))
+	}
+
+	return append(complement, Range{Start: start, End: math.Inf(1)})
+}
+
+// Format renders each Range in a RangeCollection in the form accepted by
+// ParseRangeCollection using the supplied delimiter
+func (collection RangeCollection) Format(delimiter string) []string {
+	return collection.FormatWith(delimiter, FormatFloat)
+}
+
+// FormatWith renders each Range in a RangeCollection like Format, using formatter to
+// render the bounds of each Range
+func (collection RangeCollection) FormatWith(delimiter string, formatter ValueFormatter) []string {
+	formatted := []string{}
+
+	for _, grange := range collection {
+		formatted = append(formatted, grange.FormatWith(delimiter, formatter))
+	}
+
+	return formatted
+}
+
 // Equal tests if two RangeCollections contain the same Ranges
 func (collection RangeCollection) Equal(other RangeCollection) bool {
 	if len(collection) != len(other) {
@@ -148,10 +278,16 @@ func (collection RangeCollection) Equal(other RangeCollection) bool {
 // ParseRangeCollection parses a list of Ranges in string form. If any range is not in the
 // correct format, this function will return an error
 func ParseRangeCollection(collection []string, delimiter string) (RangeCollection, error) {
+	return ParseRangeCollectionWith(collection, delimiter, ParseFloat)
+}
+
+// ParseRangeCollectionWith parses a list of Ranges like ParseRangeCollection, using parser
+// to parse the bounds of each Range
+func ParseRangeCollectionWith(collection []string, delimiter string, parser ValueParser) (RangeCollection, error) {
 	rcollection := RangeCollection{}
 
 	for _, srange := range collection {
-		grange, err := ParseRange(srange, delimiter)
+		grange, err := ParseRangeWith(srange, delimiter, parser)
 		if err != nil {
 			return rcollection, err
 		}
@@ -159,3 +159,136 @@ func TestCompareUnequalLengthRangeCollections(t *testing.T) {
 		t.Errorf("Failed! %v does not compare to %v", firstCollection, secondCollection)
 	}
 }
+
+// SET OPERATIONS:
+// Unions two RangeCollections
+func TestUnionRangeCollections(t *testing.T) {
+	firstCollection := RangeCollection{Range{Start: 5, End: 7}, Range{Start: 1, End: 2}}
+	secondCollection := RangeCollection{Range{Start: 6, End: 10}}
+	expectedCollection := RangeCollection{Range{Start: 1, End: 2}, Range{Start: 5, End: 10}}
+
+	collection := firstCollection.Union(secondCollection)
+
+	if !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, collection)
+	}
+}
+
+// Intersects two RangeCollections
+func TestIntersectRangeCollections(t *testing.T) {
+	firstCollection := RangeCollection{Range{Start: 0, End: 10}, Range{Start: 20, End: math.Inf(1)}}
+	secondCollection := RangeCollection{Range{Start: 5, End: 25}, Range{Start: 30, End: 30}}
+	expectedCollection := RangeCollection{Range{Start: 5, End: 10}, Range{Start: 20, End: 25}, Range{Start: 30, End: 30}}
+
+	collection := firstCollection.Intersect(secondCollection)
+
+	if !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, collection)
+	}
+}
+
+// Complements a RangeCollection
+func TestComplementRangeCollection(t *testing.T) {
+	collection := RangeCollection{Range{Start: 5, End: 7}, Range{Start: math.Inf(-1), End: 2}}
+	expectedCollection := RangeCollection{
+		Range{Start: math.Nextafter(2, math.Inf(1)), End: math.Nextafter(5, math.Inf(-1))},
+		Range{Start: math.Nextafter(7, math.Inf(1)), End: math.Inf(1)},
+	}
+
+	complement := collection.Complement()
+
+	if !complement.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, complement)
+	}
+
+	if !complement.Complement().Equal(collection.Merge()) {
+		t.Errorf("Failed! Expected: %v, Got: %v", collection.Merge(), complement.Complement())
+	}
+}
+
+// Complements empty and infinite RangeCollections
+func TestComplementEmptyRangeCollection(t *testing.T) {
+	infinite := RangeCollection{Range{Start: math.Inf(-1), End: math.Inf(1)}}
+
+	if !(RangeCollection{}).Complement().Equal(infinite) {
+		t.Errorf("Failed! Expected: %v, Got: %v", infinite, RangeCollection{}.Complement())
+	}
+
+	if len(infinite.Complement()) != 0 {
+		t.Errorf("Failed! Expected: %v, Got: %v", RangeCollection{}, infinite.Complement())
+	}
+}
+
+// Determines if RangeCollection contains values
+func TestRangeCollectionContains(t *testing.T) {
+	collection := RangeCollection{Range{Start: 1, End: 2}, Range{Start: 5, End: math.Inf(1)}}
+
+	if !collection.Contains(1.5) || !collection.Contains(100) {
+		t.Errorf("Failed! RangeCollection %v should contain 1.5 and 100", collection)
+	}
+
+	if collection.Contains(3) {
+		t.Errorf("Failed! RangeCollection %v should not contain 3", collection)
+	}
+}
+
+// Subtracts one RangeCollection from another
+func TestSubtractRangeCollections(t *testing.T) {
+	collection := RangeCollection{Range{Start: 0, End: 10}, Range{Start: 20, End: math.Inf(1)}}
+	other := RangeCollection{Range{Start: 5, End: 25}}
+	expectedCollection := RangeCollection{
+		Range{Start: 0, End: math.Nextafter(5, math.Inf(-1))},
+		Range{Start: math.Nextafter(25, math.Inf(1)), End: math.Inf(1)},
+	}
+
+	if subtracted := collection.Subtract(other); !subtracted.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, subtracted)
+	}
+}
+
+// Merges ranges separated by gaps within a tolerance
+func TestMergeWithToleranceRangeCollection(t *testing.T) {
+	expectedCollection := RangeCollection{Range{Start: 1, End: 9}, Range{Start: 11, End: 20}}
+	unmerged, err := ParseRangeCollection([]string{"11:20", "1:5", "6.5:9"}, ":")
+	collection := unmerged.MergeWithTolerance(1.5)
+
+	if err != nil || !collection.Equal(expectedCollection) || !unmerged.Equal(RangeCollection{Range{Start: 11, End: 20}, Range{Start: 1, End: 5}, Range{Start: 6.5, End: 9}}) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, collection)
+	}
+
+	if collection := unmerged.MergeWithTolerance(2); !collection.Equal(RangeCollection{Range{Start: 1, End: 20}}) {
+		t.Errorf("Failed! Expected: 1:20, Got: %v", collection)
+	}
+}
+
+// Merges with tolerance and infinite ends
+func TestMergeWithToleranceInfiniteRangeCollection(t *testing.T) {
+	expectedCollection := RangeCollection{Range{Start: math.Inf(-1), End: 12}, Range{Start: 20, End: math.Inf(1)}}
+	unmerged, err := ParseRangeCollection([]string{":5", "8:12", "30:", "20:25"}, ":")
+	collection := unmerged.MergeWithTolerance(5)
+
+	if err != nil || !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, collection)
+	}
+
+	if collection := unmerged.MergeWithTolerance(math.Inf(1)); !collection.Equal(RangeCollection{Range{Start: math.Inf(-1), End: math.Inf(1)}}) {
+		t.Errorf("Failed! Expected: %v, Got: %v", RangeCollection{Range{Start: math.Inf(-1), End: math.Inf(1)}}, collection)
+	}
+}
+
+// Merges adjacent integer ranges
+func TestMergeIntegersRangeCollection(t *testing.T) {
+	expectedCollection := RangeCollection{Range{Start: 1, End: 9}, Range{Start: 11, End: math.Inf(1)}}
+	unmerged, err := ParseRangeCollection([]string{"6:9", "1:5", "12:", "11"}, ":")
+	collection := unmerged.MergeIntegers()
+
+	if err != nil || !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, collection)
+	}
+
+	// 6 lies between the ranges, so they are not adjacent
+	separated := RangeCollection{Range{Start: 6.5, End: 7}, Range{Start: 1, End: 5.5}}
+	if collection := separated.MergeIntegers(); !collection.Equal(RangeCollection{Range{Start: 1, End: 5.5}, Range{Start: 6.5, End: 7}}) {
+		t.Errorf("Failed! Expected: 1:5.5 and 6.5:7, Got: %v", collection)
+	}
+}
@@ -65,6 +65,24 @@ func TestParseInvalidRange(t *testing.T) {
 	}
 }
 
+// Splits on the first delimiter even when the range parses as a single value
+func TestParseDelimiterPrefixedRange(t *testing.T) {
+	expectedRange := Range{Start: math.Inf(-1), End: 5}
+	grange, err := ParseRange("-5", "-")
+
+	if err != nil || grange != expectedRange {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedRange, grange)
+	}
+
+	if grange, err := ParseRangeWithOptions("-5", ParseOptions{Delimiters: []string{"-"}}); err != nil || grange != (Range{Start: -5, End: -5}) {
+		t.Errorf("Failed! Expected: %v, Got: %v", Range{Start: -5, End: -5}, grange)
+	}
+
+	if _, err := ParseRange("1:2:3", ":"); err == nil {
+		t.Errorf("Failed! Range with too many delimiters should be rejected")
+	}
+}
+
 // TODO: Parses range with strange separators (fuzzing??)
 // Fails to parse start before end range
 func TestDoNotParseInvalidRange(t *testing.T) {
@@ -465,3 +483,60 @@ func TestRangeDoesNotContainOutsideValue(t *testing.T) {
 		t.Errorf("Failed! Range %v does not contain %f", grange, value)
 	}
 }
+
+// Determines if range overlaps range ending before it
+func TestRangeOverlapIsSymmetric(t *testing.T) {
+	rangeOne, _ := NewRange(6, 10)
+	rangeTwo, _ := NewRange(2, 5)
+
+	if rangeOne.Overlap(rangeTwo) {
+		t.Errorf("Failed! Range %v should not overlap range %v", rangeOne, rangeTwo)
+	}
+}
+
+// INTERSECTING:
+// Intersects overlapping ranges
+func TestIntersectOverlappingRanges(t *testing.T) {
+	rangeOne, _ := NewRange(math.Inf(-1), 5)
+	rangeTwo, _ := NewRange(3, 8)
+	expectedRange, _ := NewRange(3, 5)
+
+	testRange, err := rangeOne.Intersect(rangeTwo)
+
+	if err != nil || testRange != expectedRange {
+		t.Errorf("Failure! Range %v failed to intersect with range %v", rangeOne, rangeTwo)
+	}
+}
+
+// Does not intersect non-overlapping ranges
+func TestDoNotIntersectNonOverlappingRanges(t *testing.T) {
+	rangeOne, _ := NewRange(1, 4)
+	rangeTwo, _ := NewRange(5, 7)
+
+	_, err := rangeOne.Intersect(rangeTwo)
+
+	if err == nil {
+		t.Errorf("Failure! Range %v does not overlap range %v", rangeOne, rangeTwo)
+	}
+}
+
+// FORMATTING:
+// Formats ranges in parseable form
+func TestFormatRange(t *testing.T) {
+	ranges := map[string]Range{
+		":":      {Start: math.Inf(-1), End: math.Inf(1)},
+		":4":     {Start: math.Inf(-1), End: 4},
+		"3:":     {Start: 3, End: math.Inf(1)},
+		"3":      {Start: 3, End: 3},
+		"-3:4.5": {Start: -3, End: 4.5},
+	}
+
+	for expected, grange := range ranges {
+		formatted := grange.Format(":")
+		parsed, err := ParseRange(formatted, ":")
+
+		if formatted != expected || err != nil || parsed != grange {
+			t.Errorf("Failed! Expected: %s, Got: %s", expected, formatted)
+		}
+	}
+}
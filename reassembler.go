@@ -0,0 +1,234 @@
+package gorange
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ReaderWriterAt is the storage a Reassembler writes received chunks to, such as an
+// *os.File
+type ReaderWriterAt interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// ConflictError is returned when a chunk overlaps bytes that were already received with
+// different data
+type ConflictError struct {
+	// The received bytes overlapped by the chunk
+	Range Range
+	// The first offset at which the chunk differs from the received bytes
+	Offset int64
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("Chunk conflicts with received bytes %v at offset %d", e.Range.Format(":"), e.Offset)
+}
+
+// Reassembler writes chunks of a file of known size arriving in any order, tracking the
+// byte offsets received as a merged RangeCollection of integer Ranges. A Reassembler is
+// safe for concurrent use if its storage is.
+type Reassembler struct {
+	mutex    sync.Mutex
+	storage  ReaderWriterAt
+	size     int64
+	received RangeCollection
+}
+
+// NewReassembler creates a Reassembler for a file of size bytes that writes chunks to
+// storage
+func NewReassembler(storage ReaderWriterAt, size int64) (*Reassembler, error) {
+	if size < 0 {
+		return nil, errors.New(fmt.Sprintf("Invalid size: %d", size))
+	}
+
+	return &Reassembler{storage: storage, size: size, received: RangeCollection{}}, nil
+}
+
+// Write writes a chunk of data received at offset. Bytes that were already received are
+// compared with the chunk rather than rewritten, and a *ConflictError is returned if
+// they differ. It will return an error if the chunk extends beyond the end of the file.
+func (r *Reassembler) Write(offset int64, data []byte) error {
+	if offset < 0 || offset+int64(len(data)) > r.size {
+		return errors.New(fmt.Sprintf("Chunk at offset %d of length %d is outside file of size %d", offset, len(data), r.size))
+	} else if len(data) == 0 {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	chunk := Range{Start: float64(offset), End: float64(offset + int64(len(data)) - 1)}
+
+	for _, overlap := range r.received.Intersect(RangeCollection{chunk}) {
+		start, end := int64(overlap.Start), int64(overlap.End)+1
+		existing := make([]byte, end-start)
+		if _, err := r.storage.ReadAt(existing, start); err != nil {
+			return err
+		}
+
+		expected := data[start-offset : end-offset]
+		if !bytes.Equal(existing, expected) {
+			differs := start
+			for existing[differs-start] == expected[differs-start] {
+				differs++
+			}
+			return &ConflictError{Range: overlap, Offset: differs}
+		}
+	}
+
+	missing := RangeCollection{chunk}
+	for _, grange := range r.received {
+		missing = removeIntegers(missing, grange)
+	}
+
+	for _, grange := range missing {
+		start, end := int64(grange.Start), int64(grange.End)+1
+		if _, err := r.storage.WriteAt(data[start-offset:end-offset], start); err != nil {
+			return err
+		}
+	}
+
+	r.received = append(r.received, chunk).MergeIntegers()
+	return nil
+}
+
+// Size returns the size of the file in bytes
+func (r *Reassembler) Size() int64 {
+	return r.size
+}
+
+// Received returns the merged RangeCollection of byte offsets that have been received
+func (r *Reassembler) Received() RangeCollection {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return NewRangeCollection(r.received)
+}
+
+// Missing returns the merged RangeCollection of byte offsets that have not been received
+func (r *Reassembler) Missing() RangeCollection {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.missing()
+}
+
+func (r *Reassembler) missing() RangeCollection {
+	if r.size == 0 {
+		return RangeCollection{}
+	}
+
+	missing := RangeCollection{Range{Start: 0, End: float64(r.size - 1)}}
+	for _, grange := range r.received {
+		missing = removeIntegers(missing, grange)
+	}
+
+	return missing
+}
+
+// Complete tests if every byte of the file has been received
+func (r *Reassembler) Complete() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.missing()) == 0
+}
+
+// SaveState writes the size and received byte offsets of the Reassembler to a sidecar
+// file at path, replacing it atomically so that an interrupted save never leaves a
+// partial sidecar behind
+func (r *Reassembler) SaveState(path string) error {
+	r.mutex.Lock()
+	builder := strings.Builder{}
+	builder.WriteString("size " + strconv.FormatInt(r.size, 10) + "\n")
+	for _, grange := range r.received {
+		builder.WriteString("received " + grange.Format(":") + "\n")
+	}
+	r.mutex.Unlock()
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.WriteString(builder.String()); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+
+	// Flush the contents to disk before the rename, which could otherwise survive a crash
+	// that loses them
+	if err := file.Sync(); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// ResumeReassembler creates a Reassembler that writes chunks to storage from the state
+// saved by SaveState to the sidecar file at path
+func ResumeReassembler(storage ReaderWriterAt, path string) (*Reassembler, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	size := int64(-1)
+	received := RangeCollection{}
+
+	for i, line := range strings.Split(string(contents), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		} else if len(fields) != 2 {
+			return nil, errors.New(fmt.Sprintf("Line %d: expected a name and a value, found %q", i+1, line))
+		}
+
+		switch fields[0] {
+		case "size":
+			if size, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+				return nil, errors.New(fmt.Sprintf("Line %d: %v", i+1, err))
+			}
+		case "received":
+			grange, err := ParseRange(fields[1], ":")
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("Line %d: %v", i+1, err))
+			}
+			received = append(received, grange)
+		default:
+			return nil, errors.New(fmt.Sprintf("Line %d: unknown section %q", i+1, fields[0]))
+		}
+	}
+
+	reassembler, err := NewReassembler(storage, size)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grange := range received {
+		if err := checkIntegerRange(grange); err != nil {
+			return nil, err
+		} else if grange.Start < 0 || grange.End >= float64(size) {
+			return nil, errors.New(fmt.Sprintf("Received range %v is outside file of size %d", grange, size))
+		}
+	}
+	reassembler.received = received.MergeIntegers()
+
+	return reassembler, nil
+}
@@ -0,0 +1,88 @@
+package gorange
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// REASSEMBLING:
+// Writes chunks out of order and reports missing bytes
+func TestReassembler(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "download"))
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+	defer file.Close()
+
+	content := []byte("0123456789abcdef")
+	reassembler, _ := NewReassembler(file, int64(len(content)))
+
+	for _, chunk := range [][2]int{{10, 14}, {0, 4}, {3, 7}} {
+		if err := reassembler.Write(int64(chunk[0]), content[chunk[0]:chunk[1]]); err != nil {
+			t.Errorf("Failed! Error: %v", err)
+		}
+	}
+
+	expectedMissing := RangeCollection{Range{Start: 7, End: 9}, Range{Start: 14, End: 15}}
+	if missing := reassembler.Missing(); !missing.Equal(expectedMissing) || reassembler.Complete() {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedMissing, missing)
+	}
+
+	reassembler.Write(6, content[6:])
+
+	written, _ := os.ReadFile(file.Name())
+	if !reassembler.Complete() || string(written) != string(content) {
+		t.Errorf("Failed! Expected: %s, Got: %s", content, written)
+	}
+
+	if err := reassembler.Write(12, []byte("abcdefgh")); err == nil {
+		t.Errorf("Failed! Chunk beyond the end of the file should be rejected")
+	}
+}
+
+// Detects chunks that conflict with received bytes
+func TestReassemblerConflict(t *testing.T) {
+	file, _ := os.Create(filepath.Join(t.TempDir(), "download"))
+	defer file.Close()
+
+	reassembler, _ := NewReassembler(file, 10)
+	reassembler.Write(2, []byte("abcd"))
+
+	var conflict *ConflictError
+	err := reassembler.Write(0, []byte("xxabXd"))
+	if !errors.As(err, &conflict) || !conflict.Range.Equal(Range{Start: 2, End: 5}) || conflict.Offset != 4 {
+		t.Errorf("Failed! Expected conflict at offset 4, Got: %v", err)
+	}
+
+	if received := reassembler.Received(); !received.Equal(RangeCollection{Range{Start: 2, End: 5}}) {
+		t.Errorf("Failed! Conflicting chunk should not be recorded: %v", received)
+	}
+}
+
+// Saves and resumes state from a sidecar file
+func TestReassemblerResume(t *testing.T) {
+	dir := t.TempDir()
+	file, _ := os.Create(filepath.Join(dir, "download"))
+	defer file.Close()
+	sidecar := filepath.Join(dir, "download.state")
+
+	reassembler, _ := NewReassembler(file, 100)
+	reassembler.Write(0, make([]byte, 10))
+	reassembler.Write(50, make([]byte, 25))
+
+	if err := reassembler.SaveState(sidecar); err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	resumed, err := ResumeReassembler(file, sidecar)
+	if err != nil || resumed.Size() != 100 || !resumed.Missing().Equal(reassembler.Missing()) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", reassembler.Missing(), resumed, err)
+	}
+
+	os.WriteFile(sidecar, []byte("size 10\nreceived 5:20\n"), 0644)
+	if _, err := ResumeReassembler(file, sidecar); err == nil {
+		t.Errorf("Failed! Sidecar with range outside the file should be rejected")
+	}
+}
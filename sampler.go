@@ -0,0 +1,167 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+)
+
+// maxSampledIntegers is the largest number of integers a Sampler can draw from
+const maxSampledIntegers = 1 << 62
+
+// Sampler draws random values from the Ranges of a RangeCollection. Values are drawn
+// uniformly from the values of the RangeCollection, so each Range is chosen in proportion
+// to its length, and integers are drawn uniformly from the integers it contains. When
+// every Range is a single value, each is chosen with equal probability. A Sampler is not
+// safe for concurrent use.
+type Sampler struct {
+	random     *rand.Rand
+	collection RangeCollection
+	lengths    []float64
+	integers   []uint64
+}
+
+// NewSampler creates a Sampler that draws values from a RangeCollection using source. It
+// will return an error if the RangeCollection is empty or contains an open-ended Range.
+func NewSampler(collection RangeCollection, source rand.Source) (*Sampler, error) {
+	merged := NewRangeCollection(collection).Merge()
+
+	for _, grange := range merged {
+		if math.IsInf(grange.Start, 0) || math.IsInf(grange.End, 0) {
+			return nil, errors.New(fmt.Sprintf("Range %v is unbounded, provide a clamp to sample from it", grange))
+		}
+	}
+
+	if len(merged) == 0 {
+		return nil, errors.New("Cannot sample from an empty RangeCollection")
+	}
+
+	sampler := &Sampler{random: rand.New(source), collection: merged}
+
+	length, integers := 0.0, uint64(0)
+	for _, grange := range merged {
+		length += grange.Length()
+		sampler.lengths = append(sampler.lengths, length)
+
+		if start, end := math.Ceil(grange.Start), math.Floor(grange.End); start <= end {
+			// Counts saturate rather than overflow, and are rejected when drawing integers
+			integers = min(integers+uint64(math.Min(end-start+1, maxSampledIntegers)), maxSampledIntegers)
+		}
+		sampler.integers = append(sampler.integers, integers)
+	}
+
+	return sampler, nil
+}
+
+// NewClampedSampler creates a Sampler like NewSampler from the values of a RangeCollection
+// within a finite clamp, so that open-ended Ranges can be sampled
+func NewClampedSampler(collection RangeCollection, clamp Range, source rand.Source) (*Sampler, error) {
+	if math.IsInf(clamp.Start, 0) || math.IsInf(clamp.End, 0) {
+		return nil, errors.New(fmt.Sprintf("Clamp %v is unbounded", clamp))
+	}
+
+	return NewSampler(collection.Intersect(RangeCollection{clamp}), source)
+}
+
+// Float64 returns a random value from the RangeCollection
+func (s *Sampler) Float64() float64 {
+	total := s.lengths[len(s.lengths)-1]
+	if total == 0 {
+		grange := s.collection[s.random.IntN(len(s.collection))]
+		return grange.Start
+	}
+
+	target := s.random.Float64() * total
+	index := sort.Search(len(s.lengths), func(i int) bool {
+		return s.lengths[i] > target
+	})
+	if index == len(s.lengths) {
+		index--
+	}
+
+	grange := s.collection[index]
+	return math.Min(grange.Start+s.random.Float64()*grange.Length(), grange.End)
+}
+
+// Sample returns n random values from the RangeCollection, drawn with replacement
+func (s *Sampler) Sample(n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = s.Float64()
+	}
+
+	return values
+}
+
+// Int returns a random integer from the RangeCollection. It will return an error if the
+// RangeCollection contains no integers.
+func (s *Sampler) Int() (float64, error) {
+	total, err := s.integerCount()
+	if err != nil {
+		return 0, err
+	}
+
+	return s.integer(s.random.Uint64N(total)), nil
+}
+
+// SampleIntegers returns n distinct random integers from the RangeCollection, drawn
+// without replacement in random order. It will return an error if the RangeCollection
+// contains fewer than n integers.
+func (s *Sampler) SampleIntegers(n int) ([]float64, error) {
+	total, err := s.integerCount()
+	if err != nil {
+		return nil, err
+	} else if n < 0 || uint64(n) > total {
+		return nil, errors.New(fmt.Sprintf("Cannot draw %d integers from %d", n, total))
+	}
+
+	// Floyd's algorithm picks n distinct indexes in O(n) time regardless of the total
+	chosen := make(map[uint64]bool, n)
+	indexes := make([]uint64, 0, n)
+	for j := total - uint64(n); j < total; j++ {
+		index := s.random.Uint64N(j + 1)
+		if chosen[index] {
+			index = j
+		}
+		chosen[index] = true
+		indexes = append(indexes, index)
+	}
+
+	s.random.Shuffle(len(indexes), func(i, j int) {
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+	})
+
+	values := make([]float64, n)
+	for i, index := range indexes {
+		values[i] = s.integer(index)
+	}
+
+	return values, nil
+}
+
+func (s *Sampler) integerCount() (uint64, error) {
+	total := s.integers[len(s.integers)-1]
+	if total == 0 {
+		return 0, errors.New(fmt.Sprintf("RangeCollection %v contains no integers", s.collection))
+	} else if total >= maxSampledIntegers {
+		return 0, errors.New(fmt.Sprintf("RangeCollection %v contains too many integers to sample", s.collection))
+	}
+
+	return total, nil
+}
+
+// integer returns the integer at an index into the integers of the RangeCollection
+func (s *Sampler) integer(index uint64) float64 {
+	i := sort.Search(len(s.integers), func(i int) bool {
+		return s.integers[i] > index
+	})
+
+	before := uint64(0)
+	if i > 0 {
+		before = s.integers[i-1]
+	}
+
+	return math.Ceil(s.collection[i].Start) + float64(index-before)
+}
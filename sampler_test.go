@@ -0,0 +1,110 @@
+package gorange
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// SAMPLING:
+// Draws values weighted by the length of each range
+func TestSamplerFloat64(t *testing.T) {
+	collection := RangeCollection{Range{Start: 0, End: 1}, Range{Start: 10, End: 13}}
+	sampler, err := NewSampler(collection, rand.NewPCG(1, 2))
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	high := 0
+	for _, value := range sampler.Sample(10000) {
+		if !collection.Contains(value) {
+			t.Fatalf("Failed! Value %v is not in %v", value, collection)
+		}
+		if value >= 10 {
+			high++
+		}
+	}
+
+	// Three quarters of the measure is in the second range
+	if high < 7250 || high > 7750 {
+		t.Errorf("Failed! Expected about 7500 values in the second range, Got: %v", high)
+	}
+
+	singles, _ := NewSampler(RangeCollection{Range{Start: 1, End: 1}, Range{Start: 2, End: 2}}, rand.NewPCG(1, 2))
+	if value := singles.Float64(); value != 1 && value != 2 {
+		t.Errorf("Failed! Expected 1 or 2, Got: %v", value)
+	}
+}
+
+// Draws the same values from the same source
+func TestSamplerReproducible(t *testing.T) {
+	collection := RangeCollection{Range{Start: -5, End: 5}}
+	first, _ := NewSampler(collection, rand.NewPCG(7, 7))
+	second, _ := NewSampler(collection, rand.NewPCG(7, 7))
+
+	for i := 0; i < 10; i++ {
+		if a, b := first.Float64(), second.Float64(); a != b {
+			t.Errorf("Failed! Expected equal values, Got: %v and %v", a, b)
+		}
+	}
+}
+
+// Draws integers with and without replacement
+func TestSamplerIntegers(t *testing.T) {
+	collection := RangeCollection{Range{Start: 0.5, End: 3}, Range{Start: 10, End: 11.5}}
+	sampler, _ := NewSampler(collection, rand.NewPCG(3, 4))
+
+	for i := 0; i < 100; i++ {
+		if value, err := sampler.Int(); err != nil || value != math.Trunc(value) || !collection.Contains(value) {
+			t.Fatalf("Failed! Expected an integer in %v, Got: %v, Error: %v", collection, value, err)
+		}
+	}
+
+	values, err := sampler.SampleIntegers(5)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	seen := map[float64]bool{}
+	for _, value := range values {
+		seen[value] = true
+	}
+	for _, expected := range []float64{1, 2, 3, 10, 11} {
+		if !seen[expected] {
+			t.Errorf("Failed! Expected every integer once, Got: %v", values)
+		}
+	}
+
+	if _, err := sampler.SampleIntegers(6); err == nil {
+		t.Errorf("Failed! Drawing more integers than available should be rejected")
+	}
+
+	fractions, _ := NewSampler(RangeCollection{Range{Start: 0.25, End: 0.75}}, rand.NewPCG(1, 1))
+	if _, err := fractions.Int(); err == nil {
+		t.Errorf("Failed! Drawing integers from a collection without any should be rejected")
+	}
+}
+
+// Refuses unbounded ranges unless clamped
+func TestSamplerClamp(t *testing.T) {
+	collection := RangeCollection{Range{Start: 0, End: math.Inf(1)}}
+
+	if _, err := NewSampler(collection, rand.NewPCG(1, 1)); err == nil {
+		t.Errorf("Failed! Unbounded collection should be rejected")
+	}
+
+	sampler, err := NewClampedSampler(collection, Range{Start: -10, End: 10}, rand.NewPCG(1, 1))
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	for _, value := range sampler.Sample(100) {
+		if value < 0 || value > 10 {
+			t.Errorf("Failed! Value %v is outside 0:10", value)
+		}
+	}
+
+	if _, err := NewClampedSampler(collection, Range{Start: -5, End: -1}, rand.NewPCG(1, 1)); err == nil {
+		t.Errorf("Failed! Clamp excluding every value should be rejected")
+	}
+}
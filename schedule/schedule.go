@@ -0,0 +1,182 @@
+// Package schedule computes free/busy time, free slots and conflicts for calendar
+// bookings on top of gorange.
+//
+// Times are represented by gorange.Ranges of Unix seconds with microsecond precision.
+// Bookings end at the instant the next one may start, so an Interval from 9:00 to 10:00
+// ends on the nearest representable value before 10:00, and a booking from 10:00 to
+// 11:00 does not conflict with it.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/tkmcclellan/gorange"
+)
+
+// Interval creates a Range containing every instant from start up to, but not including,
+// end. It will return an error if end is not after start.
+func Interval(start time.Time, end time.Time) (gorange.Range, error) {
+	if !end.After(start) {
+		return gorange.Range{}, errors.New(fmt.Sprintf("Interval end %v is not after start %v", end, start))
+	}
+
+	return gorange.NewRange(unixSeconds(start), math.Nextafter(unixSeconds(end), math.Inf(-1)))
+}
+
+// Times returns the start and end of a Range created by Interval
+func Times(grange gorange.Range) (time.Time, time.Time) {
+	return fromUnixSeconds(grange.Start), fromUnixSeconds(math.Nextafter(grange.End, math.Inf(1)))
+}
+
+// FreeBusy returns the merged RangeCollection of times that any of the calendars is busy
+func FreeBusy(calendars ...gorange.RangeCollection) gorange.RangeCollection {
+	busy := gorange.RangeCollection{}
+	for _, calendar := range calendars {
+		busy = busy.Union(calendar)
+	}
+
+	return join(busy)
+}
+
+// Constraint limits the times at which a slot may be booked
+type Constraint interface {
+	// Available returns the merged RangeCollection of times within a bounded Range at
+	// which a slot may be booked
+	Available(within gorange.Range) gorange.RangeCollection
+}
+
+// Busy is a Constraint that excludes the times of a calendar's bookings
+type Busy gorange.RangeCollection
+
+// Available returns the times within a Range that are not booked
+func (busy Busy) Available(within gorange.Range) gorange.RangeCollection {
+	return gorange.RangeCollection{within}.Subtract(gorange.RangeCollection(busy))
+}
+
+// WeeklyWindow is a window of time on one day of the week. Start and End are measured
+// from midnight in wall clock time, and End may be more than a day after midnight for
+// windows that continue overnight.
+type WeeklyWindow struct {
+	Day   time.Weekday
+	Start time.Duration
+	End   time.Duration
+}
+
+// WorkingHours is a Constraint that only allows the times of recurring weekly windows
+type WorkingHours struct {
+	Location *time.Location
+	Windows  []WeeklyWindow
+}
+
+// Weekdays creates WorkingHours from start to end, measured from midnight, on every day
+// from Monday to Friday
+func Weekdays(start time.Duration, end time.Duration, location *time.Location) WorkingHours {
+	hours := WorkingHours{Location: location}
+	for day := time.Monday; day <= time.Friday; day++ {
+		hours.Windows = append(hours.Windows, WeeklyWindow{Day: day, Start: start, End: end})
+	}
+
+	return hours
+}
+
+// Available returns the times within a bounded Range that fall in any window
+func (hours WorkingHours) Available(within gorange.Range) gorange.RangeCollection {
+	location := hours.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	// Start a week early so that windows continuing from earlier days are included
+	start, end := Times(within)
+	start = start.In(location).AddDate(0, 0, -7)
+
+	windows := gorange.RangeCollection{}
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location); !day.After(end); day = day.AddDate(0, 0, 1) {
+		for _, window := range hours.Windows {
+			if window.Day != day.Weekday() {
+				continue
+			}
+
+			interval, err := Interval(wallClock(day, window.Start), wallClock(day, window.End))
+			if err == nil {
+				windows = append(windows, interval)
+			}
+		}
+	}
+
+	return join(windows.Intersect(gorange.RangeCollection{within}))
+}
+
+// FindSlots returns the earliest slot of the given duration in each window of time within
+// a bounded Range that satisfies every constraint, in order
+func FindSlots(duration time.Duration, within gorange.Range, constraints ...Constraint) ([]gorange.Range, error) {
+	if duration <= 0 {
+		return nil, errors.New(fmt.Sprintf("Invalid slot duration: %v", duration))
+	} else if math.IsInf(within.Start, 0) || math.IsInf(within.End, 0) {
+		return nil, errors.New(fmt.Sprintf("Range %v is unbounded", within))
+	}
+
+	available := gorange.RangeCollection{within}
+	for _, constraint := range constraints {
+		available = available.Intersect(constraint.Available(within))
+	}
+	available = join(available)
+
+	slots := []gorange.Range{}
+	for _, window := range available {
+		start, end := Times(window)
+		if slotEnd := start.Add(duration); !slotEnd.After(end) {
+			slot, _ := Interval(start, slotEnd)
+			slots = append(slots, slot)
+		}
+	}
+
+	return slots, nil
+}
+
+// Conflicts returns the busy times of each attendee that overlap a proposed booking.
+// Attendees without conflicts are omitted.
+func Conflicts(proposed gorange.Range, attendees map[string]gorange.RangeCollection) map[string]gorange.RangeCollection {
+	conflicts := map[string]gorange.RangeCollection{}
+
+	for attendee, calendar := range attendees {
+		if overlap := calendar.Intersect(gorange.RangeCollection{proposed}); len(overlap) > 0 {
+			conflicts[attendee] = overlap
+		}
+	}
+
+	return conflicts
+}
+
+// join joins the Ranges of a merged RangeCollection where one ends at the instant the
+// next starts, so that back-to-back bookings form a single busy time
+func join(collection gorange.RangeCollection) gorange.RangeCollection {
+	joined := gorange.RangeCollection{}
+
+	for _, grange := range collection {
+		if last := len(joined) - 1; last >= 0 && math.Nextafter(joined[last].End, math.Inf(1)) >= grange.Start {
+			joined[last].End = math.Max(joined[last].End, grange.End)
+		} else {
+			joined = append(joined, grange)
+		}
+	}
+
+	return joined
+}
+
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixMicro()) / 1e6
+}
+
+func fromUnixSeconds(seconds float64) time.Time {
+	return time.UnixMicro(int64(math.Round(seconds * 1e6))).UTC()
+}
+
+// wallClock returns the time an offset after midnight on a day in wall clock time, so
+// that windows keep their local hours across daylight saving changes
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, int(offset), day.Location())
+}
@@ -0,0 +1,120 @@
+package schedule
+
+import (
+	"math"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/tkmcclellan/gorange"
+)
+
+func at(hour int, minute int) time.Time {
+	return time.Date(2024, time.March, 4, hour, minute, 0, 0, time.UTC)
+}
+
+func interval(t *testing.T, start time.Time, end time.Time) gorange.Range {
+	grange, err := Interval(start, end)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	return grange
+}
+
+// INTERVALS:
+// Back to back intervals do not overlap
+func TestInterval(t *testing.T) {
+	first, second := interval(t, at(9, 0), at(10, 0)), interval(t, at(10, 0), at(11, 0))
+
+	if first.Overlap(second) {
+		t.Errorf("Failed! %v should not overlap %v", first, second)
+	}
+
+	if start, end := Times(first); !start.Equal(at(9, 0)) || !end.Equal(at(10, 0)) {
+		t.Errorf("Failed! Expected: %v to %v, Got: %v to %v", at(9, 0), at(10, 0), start, end)
+	}
+
+	if _, err := Interval(at(10, 0), at(10, 0)); err == nil {
+		t.Errorf("Failed! Empty interval should be rejected")
+	}
+}
+
+// FREE/BUSY:
+// Merges busy times across calendars
+func TestFreeBusy(t *testing.T) {
+	alice := gorange.RangeCollection{interval(t, at(9, 0), at(10, 0)), interval(t, at(13, 0), at(14, 0))}
+	bob := gorange.RangeCollection{interval(t, at(10, 0), at(11, 30))}
+
+	expected := gorange.RangeCollection{interval(t, at(9, 0), at(11, 30)), interval(t, at(13, 0), at(14, 0))}
+	if busy := FreeBusy(alice, bob); !busy.Equal(expected) {
+		t.Errorf("Failed! Expected: %v, Got: %v", expected, busy)
+	}
+}
+
+// SLOTS:
+// Finds slots within working hours around busy times
+func TestFindSlots(t *testing.T) {
+	busy := Busy{interval(t, at(9, 0), at(10, 0)), interval(t, at(10, 30), at(16, 30))}
+	within := interval(t, at(0, 0), at(0, 0).AddDate(0, 0, 2))
+
+	slots, err := FindSlots(30*time.Minute, within, busy, Weekdays(9*time.Hour, 17*time.Hour, time.UTC))
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	expected := []time.Time{at(10, 0), at(16, 30), at(9, 0).AddDate(0, 0, 1)}
+	if len(slots) != len(expected) {
+		t.Fatalf("Failed! Expected slots at: %v, Got: %v", expected, slots)
+	}
+
+	for i, slot := range slots {
+		if start, end := Times(slot); !start.Equal(expected[i]) || end.Sub(start) != 30*time.Minute {
+			t.Errorf("Failed! Expected slot at: %v, Got: %v to %v", expected[i], start, end)
+		}
+	}
+
+	if _, err := FindSlots(time.Hour, gorange.Range{Start: 0, End: math.Inf(1)}); err == nil {
+		t.Errorf("Failed! Unbounded search should be rejected")
+	}
+}
+
+// Keeps local working hours across daylight saving changes
+func TestWorkingHoursDaylightSaving(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	// Daylight saving time started on Sunday 10 March 2024
+	hours := WorkingHours{Location: newYork, Windows: []WeeklyWindow{{Day: time.Monday, Start: 9 * time.Hour, End: 17 * time.Hour}}}
+	within := interval(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, newYork), time.Date(2024, time.March, 12, 0, 0, 0, 0, newYork))
+
+	available := hours.Available(within)
+	if len(available) != 2 {
+		t.Fatalf("Failed! Expected two windows, Got: %v", available)
+	}
+
+	for _, window := range available {
+		if start, end := Times(window); start.In(newYork).Hour() != 9 || end.In(newYork).Hour() != 17 {
+			t.Errorf("Failed! Expected 9:00 to 17:00, Got: %v to %v", start.In(newYork), end.In(newYork))
+		}
+	}
+}
+
+// CONFLICTS:
+// Reports the conflicting busy times of each attendee
+func TestConflicts(t *testing.T) {
+	attendees := map[string]gorange.RangeCollection{
+		"alice": {interval(t, at(9, 0), at(10, 0))},
+		"bob":   {interval(t, at(11, 0), at(12, 0))},
+		"carol": {interval(t, at(9, 30), at(11, 30))},
+	}
+
+	conflicts := Conflicts(interval(t, at(10, 0), at(11, 0)), attendees)
+	expected := gorange.RangeCollection{interval(t, at(10, 0), at(11, 0))}
+
+	if len(conflicts) != 1 || !conflicts["carol"].Equal(expected) {
+		t.Errorf("Failed! Expected carol to conflict at %v, Got: %v", expected, conflicts)
+	}
+}
@@ -0,0 +1,143 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ValueSeq is an iterator over values that calls yield with each value in turn until
+// yield returns false. It has the shape of iter.Seq[float64], so it can be ranged over
+// directly from Go 1.23.
+type ValueSeq func(yield func(float64) bool)
+
+// collect returns the values of the iterator as a slice
+func (seq ValueSeq) collect() []float64 {
+	values := []float64{}
+	seq(func(value float64) bool {
+		values = append(values, value)
+		return true
+	})
+
+	return values
+}
+
+// Linspace returns n evenly spaced values from the start of a finite range, like numpy's
+// linspace. The values end exactly on the end of the range if includeEnd is true, and
+// one step before it otherwise.
+func (r Range) Linspace(n int, includeEnd bool) ([]float64, error) {
+	seq, err := r.LinspaceSeq(n, includeEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return seq.collect(), nil
+}
+
+// LinspaceSeq returns an iterator over the values of Linspace
+func (r Range) LinspaceSeq(n int, includeEnd bool) (ValueSeq, error) {
+	if err := checkSpacing(r, n); err != nil {
+		return nil, err
+	}
+
+	divisions := n
+	if includeEnd {
+		divisions = n - 1
+	}
+	step := 0.0
+	if divisions > 0 {
+		step = (r.End - r.Start) / float64(divisions)
+	}
+
+	return spaced(n, includeEnd, r.End, func(i int) float64 {
+		return r.Start + float64(i)*step
+	}), nil
+}
+
+// Logspace returns n values evenly spaced on a logarithmic scale, like numpy's logspace.
+// The start and end of the finite range are the exponents of base for the first and last
+// values, so Range{Start: 0, End: 2}.Logspace(3, 10) returns [1 10 100].
+func (r Range) Logspace(n int, base float64) ([]float64, error) {
+	seq, err := r.LogspaceSeq(n, base)
+	if err != nil {
+		return nil, err
+	}
+
+	return seq.collect(), nil
+}
+
+// LogspaceSeq returns an iterator over the values of Logspace
+func (r Range) LogspaceSeq(n int, base float64) (ValueSeq, error) {
+	if base <= 0 || math.IsInf(base, 0) || math.IsNaN(base) {
+		return nil, errors.New(fmt.Sprintf("Invalid logarithm base: %v", base))
+	}
+
+	exponents, err := r.LinspaceSeq(n, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(float64) bool) {
+		exponents(func(exponent float64) bool {
+			return yield(math.Pow(base, exponent))
+		})
+	}, nil
+}
+
+// Geomspace returns n values forming a geometric sequence from the start to the end of a
+// finite range, like numpy's geomspace. Both ends must be non-zero and have the same sign.
+func (r Range) Geomspace(n int) ([]float64, error) {
+	seq, err := r.GeomspaceSeq(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return seq.collect(), nil
+}
+
+// GeomspaceSeq returns an iterator over the values of Geomspace
+func (r Range) GeomspaceSeq(n int) (ValueSeq, error) {
+	if err := checkSpacing(r, n); err != nil {
+		return nil, err
+	} else if r.Start == 0 || r.End == 0 || math.Signbit(r.Start) != math.Signbit(r.End) {
+		return nil, errors.New(fmt.Sprintf("Range %v must not contain zero", r))
+	}
+
+	sign := math.Copysign(1, r.Start)
+	start, end := math.Log(math.Abs(r.Start)), math.Log(math.Abs(r.End))
+	step := (end - start) / float64(n-1)
+
+	return spaced(n, true, r.End, func(i int) float64 {
+		if i == 0 {
+			return r.Start
+		}
+		return sign * math.Exp(start+float64(i)*step)
+	}), nil
+}
+
+func checkSpacing(r Range, n int) error {
+	if n < 0 {
+		return errors.New(fmt.Sprintf("Invalid number of values: %d", n))
+	} else if math.IsInf(r.Start, 0) || math.IsInf(r.End, 0) {
+		return errors.New(fmt.Sprintf("Range %v is unbounded", r))
+	}
+
+	return nil
+}
+
+// spaced returns an iterator over n values computed by fn, replacing the last value with
+// end when includeEnd is true so that rounding never misses the end of the range
+func spaced(n int, includeEnd bool, end float64, fn func(int) float64) ValueSeq {
+	return func(yield func(float64) bool) {
+		for i := 0; i < n; i++ {
+			value := fn(i)
+			if includeEnd && i == n-1 && n > 1 {
+				value = end
+			}
+
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+func equalValues(values []float64, expected []float64) bool {
+	if len(values) != len(expected) {
+		return false
+	}
+
+	for i := range values {
+		if math.Abs(values[i]-expected[i]) > 1e-9*math.Max(1, math.Abs(expected[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SPACING:
+// Spaces values linearly
+func TestLinspace(t *testing.T) {
+	tests := []struct {
+		grange     Range
+		n          int
+		includeEnd bool
+		expected   []float64
+	}{
+		{Range{Start: 0, End: 1}, 5, true, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{Range{Start: 0, End: 1}, 4, false, []float64{0, 0.25, 0.5, 0.75}},
+		{Range{Start: 10, End: -10}, 3, true, []float64{10, 0, -10}},
+		{Range{Start: 2, End: 3}, 1, true, []float64{2}},
+		{Range{Start: 2, End: 3}, 0, true, []float64{}},
+	}
+
+	for _, test := range tests {
+		values, err := test.grange.Linspace(test.n, test.includeEnd)
+		if err != nil || !equalValues(values, test.expected) {
+			t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", test.expected, values, err)
+		}
+	}
+
+	// The end is exact despite rounding
+	if values, _ := (Range{Start: 0, End: 0.3}).Linspace(4, true); values[3] != 0.3 {
+		t.Errorf("Failed! Expected last value 0.3, Got: %v", values[3])
+	}
+
+	if _, err := (Range{Start: 0, End: math.Inf(1)}).Linspace(3, true); err == nil {
+		t.Errorf("Failed! Unbounded range should be rejected")
+	}
+}
+
+// Spaces values logarithmically and geometrically
+func TestLogspaceGeomspace(t *testing.T) {
+	if values, err := (Range{Start: 0, End: 2}).Logspace(3, 10); err != nil || !equalValues(values, []float64{1, 10, 100}) {
+		t.Errorf("Failed! Expected: [1 10 100], Got: %v, Error: %v", values, err)
+	}
+
+	if values, err := (Range{Start: -1, End: -16}).Geomspace(5); err != nil || !equalValues(values, []float64{-1, -2, -4, -8, -16}) {
+		t.Errorf("Failed! Expected: [-1 -2 -4 -8 -16], Got: %v, Error: %v", values, err)
+	}
+
+	for _, grange := range []Range{{Start: 0, End: 10}, {Start: -1, End: 1}, {Start: 1, End: math.Inf(1)}} {
+		if _, err := grange.Geomspace(3); err == nil {
+			t.Errorf("Failed! Range %v should be rejected", grange)
+		}
+	}
+
+	if _, err := (Range{Start: 0, End: 2}).Logspace(3, -2); err == nil {
+		t.Errorf("Failed! Negative base should be rejected")
+	}
+}
+
+// Iterates values lazily and stops early
+func TestSpacingSeq(t *testing.T) {
+	seq, err := (Range{Start: 1, End: 1e6}).GeomspaceSeq(1 << 30)
+	if err != nil {
+		t.Fatalf("Failed! Error: %v", err)
+	}
+
+	values := []float64{}
+	seq(func(value float64) bool {
+		values = append(values, value)
+		return len(values) < 3
+	})
+
+	if len(values) != 3 || values[0] != 1 || values[2] <= values[1] {
+		t.Errorf("Failed! Expected three increasing values, Got: %v", values)
+	}
+}
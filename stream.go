@@ -0,0 +1,391 @@
+package gorange
+
+import (
+	"bufio"
+	"container/heap"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+// RangeStream is a source of Ranges. Next returns io.EOF once the stream is exhausted.
+type RangeStream interface {
+	Next() (Range, error)
+}
+
+// RangeReader is a RangeStream that parses one Range per line from an io.Reader,
+// skipping blank lines
+type RangeReader struct {
+	scanner   *bufio.Scanner
+	delimiter string
+	line      int
+}
+
+// NewRangeReader creates a RangeReader that parses ranges with the supplied delimiter
+func NewRangeReader(reader io.Reader, delimiter string) *RangeReader {
+	return &RangeReader{scanner: bufio.NewScanner(reader), delimiter: delimiter}
+}
+
+// Next returns the next Range in the reader
+func (r *RangeReader) Next() (Range, error) {
+	for r.scanner.Scan() {
+		r.line++
+
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		grange, err := ParseRange(line, r.delimiter)
+		if err != nil {
+			return Range{}, errors.New(fmt.Sprintf("Line %d: %v", r.line, err))
+		}
+
+		return grange, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return Range{}, err
+	}
+
+	return Range{}, io.EOF
+}
+
+// ChannelStream is a RangeStream that receives Ranges from a channel until it is closed
+type ChannelStream <-chan Range
+
+// Next returns the next Range received from the channel
+func (s ChannelStream) Next() (Range, error) {
+	grange, ok := <-s
+	if !ok {
+		return Range{}, io.EOF
+	}
+
+	return grange, nil
+}
+
+// CollectionStream is a RangeStream over the Ranges of a RangeCollection
+type CollectionStream struct {
+	collection RangeCollection
+	index      int
+}
+
+// NewCollectionStream creates a CollectionStream over a RangeCollection
+func NewCollectionStream(collection RangeCollection) *CollectionStream {
+	return &CollectionStream{collection: collection}
+}
+
+// Next returns the next Range in the RangeCollection
+func (s *CollectionStream) Next() (Range, error) {
+	if s.index >= len(s.collection) {
+		return Range{}, io.EOF
+	}
+
+	s.index++
+	return s.collection[s.index-1], nil
+}
+
+// WriteRanges writes every Range in a stream to writer, one per line, in the form
+// accepted by RangeReader
+func WriteRanges(writer io.Writer, stream RangeStream, delimiter string) error {
+	buffered := bufio.NewWriter(writer)
+
+	for {
+		grange, err := stream.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if _, err := buffered.WriteString(grange.Format(delimiter) + "\n"); err != nil {
+			return err
+		}
+	}
+
+	return buffered.Flush()
+}
+
+// CollectStream reads every Range in a stream into a RangeCollection
+func CollectStream(stream RangeStream) (RangeCollection, error) {
+	collection := RangeCollection{}
+
+	for {
+		grange, err := stream.Next()
+		if err == io.EOF {
+			return collection, nil
+		} else if err != nil {
+			return collection, err
+		}
+		collection = append(collection, grange)
+	}
+}
+
+type streamHead struct {
+	grange Range
+	stream int
+}
+
+type streamHeap []streamHead
+
+func (h streamHeap) Len() int {
+	return len(h)
+}
+
+func (h streamHeap) Less(i, j int) bool {
+	if h[i].grange.Start == h[j].grange.Start {
+		return h[i].grange.End < h[j].grange.End
+	}
+
+	return h[i].grange.Start < h[j].grange.Start
+}
+
+func (h streamHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *streamHeap) Push(head interface{}) {
+	*h = append(*h, head.(streamHead))
+}
+
+func (h *streamHeap) Pop() interface{} {
+	old := *h
+	head := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return head
+}
+
+// StreamMerger is a RangeStream that merges one or more streams of Ranges sorted by
+// Start into a stream of sorted, non-overlapping Ranges, holding only one Range from
+// each stream in memory at a time
+type StreamMerger struct {
+	streams []RangeStream
+	heads   streamHeap
+	last    []Range
+	current *Range
+	started bool
+	closers []io.Closer
+	err     error
+}
+
+// MergeStreams creates a StreamMerger over streams sorted by Start. Next will return an
+// error if any stream is found to be unsorted.
+func MergeStreams(streams ...RangeStream) *StreamMerger {
+	return &StreamMerger{streams: streams, last: make([]Range, len(streams))}
+}
+
+func (m *StreamMerger) advance(stream int) error {
+	grange, err := m.streams[stream].Next()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if m.last[stream].Start > grange.Start {
+		return errors.New(fmt.Sprintf("Stream %d is not sorted: %v follows %v", stream, grange, m.last[stream]))
+	}
+	m.last[stream] = grange
+
+	heap.Push(&m.heads, streamHead{grange: grange, stream: stream})
+	return nil
+}
+
+// Next returns the next merged Range
+func (m *StreamMerger) Next() (Range, error) {
+	if m.err != nil {
+		return Range{}, m.err
+	}
+
+	if !m.started {
+		m.started = true
+		for i := range m.streams {
+			m.last[i] = Range{Start: math.Inf(-1), End: math.Inf(-1)}
+			if m.err = m.advance(i); m.err != nil {
+				return Range{}, m.err
+			}
+		}
+	}
+
+	for m.heads.Len() > 0 {
+		head := heap.Pop(&m.heads).(streamHead)
+		if m.err = m.advance(head.stream); m.err != nil {
+			return Range{}, m.err
+		}
+
+		if m.current == nil {
+			m.current = &head.grange
+		} else if m.current.Overlap(head.grange) {
+			merged, _ := m.current.Merge(head.grange)
+			m.current = &merged
+		} else {
+			merged := *m.current
+			m.current = &head.grange
+			return merged, nil
+		}
+	}
+
+	if m.current != nil {
+		merged := *m.current
+		m.current = nil
+		return merged, nil
+	}
+
+	m.err = io.EOF
+	return Range{}, m.err
+}
+
+// Close closes any resources held by the StreamMerger, such as the temporary files
+// created by ExternalSort
+func (m *StreamMerger) Close() error {
+	var err error
+
+	for _, closer := range m.closers {
+		if closeErr := closer.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	m.closers = nil
+
+	return err
+}
+
+type tempRangeFile struct {
+	*os.File
+}
+
+func (f tempRangeFile) Close() error {
+	err := f.File.Close()
+	if removeErr := os.Remove(f.Name()); removeErr != nil && err == nil {
+		err = removeErr
+	}
+
+	return err
+}
+
+// externalSortFanIn is the largest number of temporary files ExternalSort reads at once
+const externalSortFanIn = 64
+
+// ExternalSort sorts and merges a stream of Ranges too large to fit in memory. Chunks
+// of up to chunkSize Ranges are merged in memory and written to temporary files in dir
+// (or the default temporary directory if dir is empty), which are then merged by the
+// returned StreamMerger. When there are more than 64 files, they are first merged into
+// intermediate files in passes, so that no more than 64 are ever open at once. The
+// StreamMerger must be closed to remove the temporary files.
+func ExternalSort(stream RangeStream, chunkSize int, dir string) (*StreamMerger, error) {
+	return externalSort(stream, chunkSize, externalSortFanIn, dir)
+}
+
+func externalSort(stream RangeStream, chunkSize int, fanIn int, dir string) (*StreamMerger, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New(fmt.Sprintf("Invalid chunk size: %d", chunkSize))
+	}
+
+	runs := []string{}
+
+	for done := false; !done; {
+		chunk := RangeCollection{}
+		for len(chunk) < chunkSize {
+			grange, err := stream.Next()
+			if err == io.EOF {
+				done = true
+				break
+			} else if err != nil {
+				removeFiles(runs)
+				return nil, err
+			}
+			chunk = append(chunk, grange)
+		}
+
+		if len(chunk) == 0 {
+			break
+		}
+
+		run, err := writeRun(NewCollectionStream(chunk.Merge()), dir)
+		if err != nil {
+			removeFiles(runs)
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	// Merge the oldest runs into one until few enough remain to be read at once
+	for len(runs) > fanIn {
+		merger, err := openRuns(runs[:fanIn])
+		if err != nil {
+			removeFiles(runs)
+			return nil, err
+		}
+
+		run, err := writeRun(merger, dir)
+		if closeErr := merger.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			removeFiles(append(runs, run))
+			return nil, err
+		}
+
+		runs = append(runs[fanIn:], run)
+	}
+
+	merger, err := openRuns(runs)
+	if err != nil {
+		removeFiles(runs)
+		return nil, err
+	}
+
+	return merger, nil
+}
+
+// writeRun writes a sorted stream of Ranges to a new temporary file in dir, returning
+// the path of the file
+func writeRun(stream RangeStream, dir string) (string, error) {
+	file, err := os.CreateTemp(dir, "gorange-*")
+	if err != nil {
+		return "", err
+	}
+
+	err = WriteRanges(file, stream, ":")
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	return file.Name(), nil
+}
+
+// openRuns creates a StreamMerger over temporary files written by writeRun, which
+// removes the files when it is closed
+func openRuns(runs []string) (*StreamMerger, error) {
+	merger := MergeStreams()
+
+	for _, run := range runs {
+		file, err := os.Open(run)
+		if err != nil {
+			merger.Close()
+			return nil, err
+		}
+		merger.closers = append(merger.closers, tempRangeFile{file})
+
+		merger.streams = append(merger.streams, NewRangeReader(file, ":"))
+		merger.last = append(merger.last, Range{})
+	}
+
+	return merger, nil
+}
+
+func removeFiles(paths []string) {
+	for _, path := range paths {
+		if path != "" {
+			os.Remove(path)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package gorange
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+)
+
+func streamTest(t *testing.T, stream RangeStream, expectedCollection RangeCollection) {
+	collection, err := CollectStream(stream)
+
+	if err != nil || !collection.Equal(expectedCollection) {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", expectedCollection, collection, err)
+	}
+}
+
+// READING:
+// Reads ranges from lines
+func TestRangeReader(t *testing.T) {
+	reader := NewRangeReader(strings.NewReader("1:2\n\n  4:\n:0\n"), ":")
+	streamTest(t, reader, RangeCollection{Range{Start: 1, End: 2}, Range{Start: 4, End: math.Inf(1)}, Range{Start: math.Inf(-1), End: 0}})
+}
+
+// Reports line of invalid range
+func TestRangeReaderInvalidLine(t *testing.T) {
+	reader := NewRangeReader(strings.NewReader("1:2\n\nx\n"), ":")
+	reader.Next()
+	_, err := reader.Next()
+
+	if err == nil || !strings.Contains(err.Error(), "Line 3") {
+		t.Errorf("Failed! Expected error on line 3, Got: %v", err)
+	}
+}
+
+// Writes ranges as lines
+func TestWriteRanges(t *testing.T) {
+	buffer := bytes.Buffer{}
+	err := WriteRanges(&buffer, NewCollectionStream(RangeCollection{Range{Start: 1, End: 2}, Range{Start: 3, End: math.Inf(1)}}), ":")
+
+	if err != nil || buffer.String() != "1:2\n3:\n" {
+		t.Errorf("Failed! Expected: %q, Got: %q, Error: %v", "1:2\n3:\n", buffer.String(), err)
+	}
+}
+
+// MERGING:
+// Merges sorted streams
+func TestMergeStreams(t *testing.T) {
+	channel := make(chan Range, 3)
+	channel <- Range{Start: 2, End: 3}
+	channel <- Range{Start: 8, End: 9}
+	channel <- Range{Start: 20, End: math.Inf(1)}
+	close(channel)
+
+	merger := MergeStreams(
+		NewRangeReader(strings.NewReader(":0\n1:2\n5:6\n"), ":"),
+		ChannelStream(channel),
+		NewCollectionStream(RangeCollection{Range{Start: 3, End: 4}, Range{Start: 9, End: 10}}),
+	)
+
+	streamTest(t, merger, RangeCollection{
+		Range{Start: math.Inf(-1), End: 0},
+		Range{Start: 1, End: 4},
+		Range{Start: 5, End: 6},
+		Range{Start: 8, End: 10},
+		Range{Start: 20, End: math.Inf(1)},
+	})
+
+	if _, err := merger.Next(); err != io.EOF {
+		t.Errorf("Failed! Expected: %v, Got: %v", io.EOF, err)
+	}
+}
+
+// Merges no streams
+func TestMergeNoStreams(t *testing.T) {
+	streamTest(t, MergeStreams(), RangeCollection{})
+}
+
+// Fails to merge unsorted stream
+func TestMergeUnsortedStream(t *testing.T) {
+	merger := MergeStreams(NewCollectionStream(RangeCollection{Range{Start: 5, End: 6}, Range{Start: 1, End: 2}}))
+
+	if collection, err := CollectStream(merger); err == nil {
+		t.Errorf("Failed! Expected failure with: %v", collection)
+	}
+}
+
+// EXTERNAL SORTING:
+// Sorts and merges unsorted stream through temporary files
+func TestExternalSort(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	collection := RangeCollection{}
+	for i := 0; i < 1000; i++ {
+		start := float64(random.Intn(10000))
+		collection = append(collection, Range{Start: start, End: start + float64(random.Intn(5))})
+	}
+	collection = append(collection, Range{Start: math.Inf(-1), End: -10})
+
+	dir := t.TempDir()
+	merger, err := ExternalSort(NewCollectionStream(collection), 64, dir)
+	if err != nil {
+		t.Fatalf("Failed! Got error: %v", err)
+	}
+
+	if files, _ := os.ReadDir(dir); len(files) != 16 {
+		t.Errorf("Failed! Expected 16 temporary files, Got: %d", len(files))
+	}
+
+	streamTest(t, merger, NewRangeCollection(collection).Merge())
+
+	if err := merger.Close(); err != nil {
+		t.Errorf("Failed! Got error: %v", err)
+	}
+
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("Failed! Expected temporary files to be removed, Got: %d", len(files))
+	}
+}
+
+// Merges temporary files in passes when there are more than can be read at once
+func TestExternalSortFanIn(t *testing.T) {
+	random := rand.New(rand.NewSource(2))
+	collection := RangeCollection{}
+	for i := 0; i < 1000; i++ {
+		start := float64(random.Intn(10000))
+		collection = append(collection, Range{Start: start, End: start + float64(random.Intn(5))})
+	}
+
+	dir := t.TempDir()
+	merger, err := externalSort(NewCollectionStream(collection), 64, 4, dir)
+	if err != nil {
+		t.Fatalf("Failed! Got error: %v", err)
+	}
+
+	if files, _ := os.ReadDir(dir); len(files) != 4 {
+		t.Errorf("Failed! Expected 4 temporary files, Got: %d", len(files))
+	}
+
+	streamTest(t, merger, NewRangeCollection(collection).Merge())
+
+	if err := merger.Close(); err != nil {
+		t.Errorf("Failed! Got error: %v", err)
+	}
+
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("Failed! Expected temporary files to be removed, Got: %d", len(files))
+	}
+}
+
+// Fails to sort with invalid chunk size
+func TestExternalSortInvalidChunkSize(t *testing.T) {
+	if _, err := ExternalSort(NewCollectionStream(RangeCollection{}), 0, ""); err == nil {
+		t.Errorf("Failed! Expected failure with chunk size 0")
+	}
+}
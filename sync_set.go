@@ -0,0 +1,80 @@
+package gorange
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncRangeSet is a set of values that is safe for concurrent use. Writers are
+// serialized and publish a new merged RangeCollection on every change, so readers never
+// block and always see a consistent point-in-time state. The zero value is an empty set.
+type SyncRangeSet struct {
+	mutex    sync.Mutex
+	snapshot atomic.Value
+}
+
+// NewSyncRangeSet creates a SyncRangeSet containing the values of the supplied Ranges
+func NewSyncRangeSet(ranges ...Range) *SyncRangeSet {
+	set := &SyncRangeSet{}
+	set.snapshot.Store(NewRangeCollection(ranges).Merge())
+
+	return set
+}
+
+func (set *SyncRangeSet) load() RangeCollection {
+	collection, _ := set.snapshot.Load().(RangeCollection)
+	return collection
+}
+
+func (set *SyncRangeSet) update(fn func(RangeCollection) RangeCollection) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.snapshot.Store(fn(set.load()))
+}
+
+// Add adds the values of the supplied Ranges to the set
+func (set *SyncRangeSet) Add(ranges ...Range) {
+	set.update(func(collection RangeCollection) RangeCollection {
+		return collection.Union(ranges)
+	})
+}
+
+// Remove removes the values of the supplied Ranges from the set
+func (set *SyncRangeSet) Remove(ranges ...Range) {
+	set.update(func(collection RangeCollection) RangeCollection {
+		return collection.Subtract(ranges)
+	})
+}
+
+// Contains tests if the set contains a given value
+func (set *SyncRangeSet) Contains(float float64) bool {
+	collection := set.load()
+	index := sort.Search(len(collection), func(i int) bool {
+		return collection[i].End >= float
+	})
+
+	return index < len(collection) && collection[index].Contains(float)
+}
+
+// ContainsRange tests if the set contains every value of a given Range
+func (set *SyncRangeSet) ContainsRange(grange Range) bool {
+	collection := set.load()
+	index := sort.Search(len(collection), func(i int) bool {
+		return collection[i].End >= grange.End
+	})
+
+	return index < len(collection) && collection[index].Start <= grange.Start
+}
+
+// Snapshot returns the merged RangeCollection of values in the set at a single point in
+// time. The returned RangeCollection is a copy that is not affected by later changes.
+func (set *SyncRangeSet) Snapshot() RangeCollection {
+	return NewRangeCollection(set.load())
+}
+
+// Len returns the number of merged Ranges in the set
+func (set *SyncRangeSet) Len() int {
+	return len(set.load())
+}
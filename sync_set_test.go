@@ -0,0 +1,105 @@
+package gorange
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+// SYNC SETS:
+// Adds, removes and contains values
+func TestSyncRangeSet(t *testing.T) {
+	set := NewSyncRangeSet(Range{Start: 0, End: 10})
+	set.Add(Range{Start: 20, End: 30}, Range{Start: 10, End: 12})
+	set.Remove(Range{Start: 5, End: 6})
+
+	expectedCollection := RangeCollection{
+		Range{Start: 0, End: math.Nextafter(5, math.Inf(-1))},
+		Range{Start: math.Nextafter(6, math.Inf(1)), End: 12},
+		Range{Start: 20, End: 30},
+	}
+
+	if snapshot := set.Snapshot(); !snapshot.Equal(expectedCollection) || set.Len() != 3 {
+		t.Errorf("Failed! Expected: %v, Got: %v", expectedCollection, snapshot)
+	}
+
+	for value, expected := range map[float64]bool{0: true, 5: false, 5.5: false, 7: true, 12: true, 15: false, 30: true, 31: false} {
+		if set.Contains(value) != expected {
+			t.Errorf("Failed! Contains(%v) should be %v", value, expected)
+		}
+	}
+
+	if !set.ContainsRange(Range{Start: 7, End: 12}) || set.ContainsRange(Range{Start: 4, End: 7}) {
+		t.Errorf("Failed! ContainsRange did not match %v", set.Snapshot())
+	}
+}
+
+// Zero value is an empty set
+func TestZeroSyncRangeSet(t *testing.T) {
+	set := SyncRangeSet{}
+
+	if set.Contains(0) || len(set.Snapshot()) != 0 {
+		t.Errorf("Failed! Zero value set should be empty: %v", set.Snapshot())
+	}
+
+	set.Add(Range{Start: 1, End: 2})
+	if !set.Contains(1.5) {
+		t.Errorf("Failed! Set %v should contain 1.5", set.Snapshot())
+	}
+}
+
+// Snapshots are unaffected by later changes
+func TestSyncRangeSetSnapshotIsolation(t *testing.T) {
+	set := NewSyncRangeSet(Range{Start: 0, End: 10})
+	snapshot := set.Snapshot()
+	snapshot[0].End = 100
+	set.Add(Range{Start: 20, End: 30})
+
+	if !snapshot.Equal(RangeCollection{Range{Start: 0, End: 100}}) || set.Contains(50) {
+		t.Errorf("Failed! Snapshot %v was not isolated from set %v", snapshot, set.Snapshot())
+	}
+}
+
+// Adds concurrently from many goroutines while reading
+func TestSyncRangeSetConcurrency(t *testing.T) {
+	set := &SyncRangeSet{}
+	var writers, readers sync.WaitGroup
+	done := make(chan struct{})
+
+	for reader := 0; reader < 4; reader++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				if snapshot := set.Snapshot(); !snapshot.IsMerged() {
+					t.Errorf("Failed! Snapshot %v is not merged", snapshot)
+				}
+				set.Contains(50)
+			}
+		}()
+	}
+
+	for writer := 0; writer < 8; writer++ {
+		writers.Add(1)
+		go func(writer int) {
+			defer writers.Done()
+			for i := writer; i < 1000; i += 8 {
+				set.Add(Range{Start: float64(i), End: float64(i)})
+			}
+		}(writer)
+	}
+
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	if len(set.Snapshot()) != 1000 || !set.ContainsRange(Range{Start: 999, End: 999}) {
+		t.Errorf("Failed! Expected 1000 ranges, Got: %d", len(set.Snapshot()))
+	}
+}
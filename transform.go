@@ -0,0 +1,110 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Shift returns a range moved by offset. Infinite bounds stay infinite.
+func (r Range) Shift(offset float64) Range {
+	return Range{Start: offsetBound(r.Start, offset), End: offsetBound(r.End, offset)}
+}
+
+// Scale returns a range with both bounds multiplied by factor. A negative factor flips the
+// range, swapping its bounds so that the start stays before the end, and a factor of zero
+// collapses the range to zero.
+func (r Range) Scale(factor float64) Range {
+	if factor == 0 {
+		return Range{Start: 0, End: 0}
+	} else if factor < 0 {
+		return Range{Start: r.End * factor, End: r.Start * factor}
+	}
+
+	return Range{Start: r.Start * factor, End: r.End * factor}
+}
+
+// Clamp returns the values of a range with each value limited to bounds, so a range
+// partly outside bounds is cut off at the bound and a range entirely outside bounds
+// collapses to the nearest bound. Use Intersect to drop values outside bounds instead.
+func (r Range) Clamp(bounds Range) Range {
+	return Range{Start: clampValue(r.Start, bounds), End: clampValue(r.End, bounds)}
+}
+
+// Expand returns a range padded by margin on both sides, or trimmed if margin is negative.
+// Infinite bounds stay infinite. It will return an error if trimming leaves no values.
+func (r Range) Expand(margin float64) (Range, error) {
+	expanded := Range{Start: offsetBound(r.Start, -margin), End: offsetBound(r.End, margin)}
+	if !(expanded.Start <= expanded.End) {
+		return r, errors.New(fmt.Sprintf("Range %v has no values left after expanding by %v", r, margin))
+	}
+
+	return expanded, nil
+}
+
+// Shrink returns a range trimmed by margin on both sides. Infinite bounds stay infinite.
+// It will return an error if trimming leaves no values.
+func (r Range) Shrink(margin float64) (Range, error) {
+	return r.Expand(-margin)
+}
+
+// Shift returns a merged RangeCollection with every Range moved by offset
+func (collection RangeCollection) Shift(offset float64) RangeCollection {
+	return collection.transform(func(grange Range) (Range, bool) {
+		return grange.Shift(offset), true
+	})
+}
+
+// Scale returns a merged RangeCollection with the bounds of every Range multiplied by
+// factor
+func (collection RangeCollection) Scale(factor float64) RangeCollection {
+	return collection.transform(func(grange Range) (Range, bool) {
+		return grange.Scale(factor), true
+	})
+}
+
+// Clamp returns a merged RangeCollection with the values of every Range limited to bounds
+func (collection RangeCollection) Clamp(bounds Range) RangeCollection {
+	return collection.transform(func(grange Range) (Range, bool) {
+		return grange.Clamp(bounds), true
+	})
+}
+
+// Expand returns a merged RangeCollection with every Range padded by margin on both
+// sides, or trimmed if margin is negative. Ranges with no values left are dropped.
+func (collection RangeCollection) Expand(margin float64) RangeCollection {
+	return collection.transform(func(grange Range) (Range, bool) {
+		expanded, err := grange.Expand(margin)
+		return expanded, err == nil
+	})
+}
+
+// Shrink returns a merged RangeCollection with every Range trimmed by margin on both
+// sides. Ranges with no values left are dropped.
+func (collection RangeCollection) Shrink(margin float64) RangeCollection {
+	return collection.Expand(-margin)
+}
+
+func (collection RangeCollection) transform(fn func(Range) (Range, bool)) RangeCollection {
+	transformed := RangeCollection{}
+
+	for _, grange := range collection {
+		if result, ok := fn(grange); ok {
+			transformed = append(transformed, result)
+		}
+	}
+
+	return transformed.Merge()
+}
+
+func offsetBound(bound float64, offset float64) float64 {
+	if math.IsInf(bound, 0) {
+		return bound
+	}
+
+	return bound + offset
+}
+
+func clampValue(float float64, bounds Range) float64 {
+	return math.Max(bounds.Start, math.Min(float, bounds.End))
+}
@@ -0,0 +1,66 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+)
+
+// TRANSFORMING:
+// Shifts, scales and clamps ranges, preserving infinities
+func TestTransformRange(t *testing.T) {
+	tests := []struct {
+		result   Range
+		expected Range
+	}{
+		{Range{Start: 1, End: 5}.Shift(-3), Range{Start: -2, End: 2}},
+		{Range{Start: math.Inf(-1), End: 5}.Shift(10), Range{Start: math.Inf(-1), End: 15}},
+		{Range{Start: 1, End: 5}.Scale(2), Range{Start: 2, End: 10}},
+		{Range{Start: 1, End: math.Inf(1)}.Scale(-2), Range{Start: math.Inf(-1), End: -2}},
+		{Range{Start: math.Inf(-1), End: math.Inf(1)}.Scale(0), Range{Start: 0, End: 0}},
+		{Range{Start: -5, End: 5}.Clamp(Range{Start: 0, End: 10}), Range{Start: 0, End: 5}},
+		{Range{Start: 20, End: math.Inf(1)}.Clamp(Range{Start: 0, End: 10}), Range{Start: 10, End: 10}},
+	}
+
+	for _, test := range tests {
+		if !test.result.Equal(test.expected) {
+			t.Errorf("Failed! Expected: %v, Got: %v", test.expected, test.result)
+		}
+	}
+}
+
+// Expands and shrinks ranges
+func TestExpandShrinkRange(t *testing.T) {
+	if expanded, err := (Range{Start: 1, End: math.Inf(1)}).Expand(2); err != nil || !expanded.Equal(Range{Start: -1, End: math.Inf(1)}) {
+		t.Errorf("Failed! Expected: -1:, Got: %v, Error: %v", expanded, err)
+	}
+
+	if shrunk, err := (Range{Start: 0, End: 10}).Shrink(5); err != nil || !shrunk.Equal(Range{Start: 5, End: 5}) {
+		t.Errorf("Failed! Expected: 5, Got: %v, Error: %v", shrunk, err)
+	}
+
+	if _, err := (Range{Start: 0, End: 10}).Shrink(6); err == nil {
+		t.Errorf("Failed! Shrinking past the middle should be rejected")
+	}
+}
+
+// Transforms collections and re-merges them
+func TestTransformRangeCollection(t *testing.T) {
+	collection := RangeCollection{Range{Start: 0, End: 2}, Range{Start: 5, End: 6}, Range{Start: 10, End: math.Inf(1)}}
+
+	tests := []struct {
+		result   RangeCollection
+		expected RangeCollection
+	}{
+		{collection.Shift(1), RangeCollection{Range{Start: 1, End: 3}, Range{Start: 6, End: 7}, Range{Start: 11, End: math.Inf(1)}}},
+		{collection.Scale(-1), RangeCollection{Range{Start: math.Inf(-1), End: -10}, Range{Start: -6, End: -5}, Range{Start: -2, End: 0}}},
+		{collection.Clamp(Range{Start: 1, End: 5}), RangeCollection{Range{Start: 1, End: 2}, Range{Start: 5, End: 5}}},
+		{collection.Expand(2), RangeCollection{Range{Start: -2, End: math.Inf(1)}}},
+		{collection.Shrink(0.75), RangeCollection{Range{Start: 0.75, End: 1.25}, Range{Start: 10.75, End: math.Inf(1)}}},
+	}
+
+	for _, test := range tests {
+		if !test.result.Equal(test.expected) || !test.result.IsMerged() {
+			t.Errorf("Failed! Expected: %v, Got: %v", test.expected, test.result)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package gorange
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type byteUnit struct {
+	suffix string
+	size   float64
+}
+
+// Byte units ordered from largest to smallest so that formatting picks the largest
+// unit that represents a value exactly
+var byteUnits = []byteUnit{
+	{"EiB", 1 << 60}, {"EB", 1e18},
+	{"PiB", 1 << 50}, {"PB", 1e15},
+	{"TiB", 1 << 40}, {"TB", 1e12},
+	{"GiB", 1 << 30}, {"GB", 1e9},
+	{"MiB", 1 << 20}, {"MB", 1e6},
+	{"KiB", 1 << 10}, {"kB", 1e3},
+	{"B", 1},
+}
+
+// ParseByteSize is a ValueParser for byte sizes with SI ("kB", "MB", ...) or IEC ("KiB",
+// "MiB", ...) unit suffixes, such as "1.5MiB". Units are case insensitive and plain
+// numbers are treated as bytes.
+func ParseByteSize(svalue string) (float64, error) {
+	number := strings.TrimRightFunc(svalue, func(r rune) bool {
+		return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+	})
+	suffix := svalue[len(number):]
+
+	size := 1.0
+	if suffix != "" {
+		found := false
+		for _, unit := range byteUnits {
+			if strings.EqualFold(suffix, unit.suffix) {
+				size, found = unit.size, true
+				break
+			}
+		}
+
+		if !found {
+			return 0, errors.New(fmt.Sprintf("Unknown byte size unit (%s)", suffix))
+		}
+	}
+
+	float, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return float * size, nil
+}
+
+// FormatByteSize is a ValueFormatter for byte sizes. It uses the largest SI or IEC unit
+// that the value is a whole multiple of.
+func FormatByteSize(float float64) string {
+	for _, unit := range byteUnits {
+		if float != 0 && math.Mod(float, unit.size) == 0 {
+			return FormatFloat(float/unit.size) + unit.suffix
+		}
+	}
+
+	return FormatFloat(float) + "B"
+}
+
+// ParseDuration is a ValueParser for durations in the form accepted by
+// time.ParseDuration, such as "100ms" or "1h30m". Values are in nanoseconds, so parsed
+// bounds may be converted with time.Duration.
+func ParseDuration(svalue string) (float64, error) {
+	duration, err := time.ParseDuration(svalue)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(duration), nil
+}
+
+// FormatDuration is a ValueFormatter for durations in nanoseconds
+func FormatDuration(float float64) string {
+	return time.Duration(float).String()
+}
+
+// ParsePercentage is a ValueParser for percentages such as "12.5%". Values are
+// fractions, so "50%" is parsed as 0.5.
+func ParsePercentage(svalue string) (float64, error) {
+	if !strings.HasSuffix(svalue, "%") {
+		return 0, errors.New(fmt.Sprintf("Percentage (%s) does not end with %%", svalue))
+	}
+
+	float, err := strconv.ParseFloat(strings.TrimSuffix(svalue, "%"), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return float / 100, nil
+}
+
+// FormatPercentage is a ValueFormatter for percentages expressed as fractions
+func FormatPercentage(float float64) string {
+	percentage, _ := strconv.ParseFloat(strconv.FormatFloat(float*100, 'g', 15, 64), 64)
+
+	return FormatFloat(percentage) + "%"
+}
+
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// ParseTimestamp is a ValueParser for RFC 3339 and PostgreSQL timestamps and dates, such
+// as "2024-01-02 15:04:05+00". Values are Unix seconds, and timestamps without a zone
+// are treated as UTC.
+func ParseTimestamp(svalue string) (float64, error) {
+	for _, layout := range timestampLayouts {
+		if timestamp, err := time.Parse(layout, svalue); err == nil {
+			return float64(timestamp.UnixMicro()) / 1e6, nil
+		}
+	}
+
+	return 0, errors.New(fmt.Sprintf("Invalid timestamp (%s)", svalue))
+}
+
+// FormatTimestamp is a ValueFormatter for Unix seconds. It renders RFC 3339 timestamps
+// in UTC with microsecond precision.
+func FormatTimestamp(float float64) string {
+	return time.UnixMicro(int64(math.Round(float * 1e6))).UTC().Format(time.RFC3339Nano)
+}
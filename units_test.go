@@ -0,0 +1,91 @@
+package gorange
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func unitRangeTest(t *testing.T, srange string, parser ValueParser, formatter ValueFormatter, expectedRange Range, expectedFormat string) {
+	grange, err := ParseRangeWith(srange, ":", parser)
+
+	if err != nil || grange != expectedRange {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", expectedRange, grange, err)
+	}
+
+	if formatted := grange.FormatWith(":", formatter); formatted != expectedFormat {
+		t.Errorf("Failed! Expected: %s, Got: %s", expectedFormat, formatted)
+	}
+}
+
+// BYTE SIZES:
+// Parses and formats IEC byte size range
+func TestIECByteSizeRange(t *testing.T) {
+	unitRangeTest(t, "1KiB:4MiB", ParseByteSize, FormatByteSize, Range{Start: 1024, End: 4 << 20}, "1KiB:4MiB")
+}
+
+// Parses and formats SI byte size range
+func TestSIByteSizeRange(t *testing.T) {
+	unitRangeTest(t, "1.5kb:2GB", ParseByteSize, FormatByteSize, Range{Start: 1500, End: 2e9}, "1500B:2GB")
+}
+
+// Parses and formats open byte size range
+func TestOpenByteSizeRange(t *testing.T) {
+	unitRangeTest(t, "512:", ParseByteSize, FormatByteSize, Range{Start: 512, End: math.Inf(1)}, "512B:")
+}
+
+// Fails to parse unknown byte size unit
+func TestInvalidByteSizeRange(t *testing.T) {
+	grange, err := ParseRangeWith("1KiB:4XB", ":", ParseByteSize)
+
+	if err == nil {
+		t.Errorf("Failed! Expected failure with: %v", grange)
+	}
+}
+
+// DURATIONS:
+// Parses and formats duration range
+func TestDurationRange(t *testing.T) {
+	expectedRange := Range{Start: float64(100 * time.Millisecond), End: float64(90 * time.Second)}
+	unitRangeTest(t, "100ms:1m30s", ParseDuration, FormatDuration, expectedRange, "100ms:1m30s")
+}
+
+// Fails to parse duration without unit
+func TestInvalidDurationRange(t *testing.T) {
+	grange, err := ParseRangeWith("100:2s", ":", ParseDuration)
+
+	if err == nil {
+		t.Errorf("Failed! Expected failure with: %v", grange)
+	}
+}
+
+// PERCENTAGES:
+// Parses and formats percentage range
+func TestPercentageRange(t *testing.T) {
+	unitRangeTest(t, "7%:12.5%", ParsePercentage, FormatPercentage, Range{Start: 0.07, End: 0.125}, "7%:12.5%")
+}
+
+// Parses and formats percentage collection
+func TestPercentageRangeCollection(t *testing.T) {
+	collection, err := ParseRangeCollectionWith([]string{":10%", "90%"}, ":", ParsePercentage)
+	formatted := collection.FormatWith(":", FormatPercentage)
+
+	if err != nil || len(formatted) != 2 || formatted[0] != ":10%" || formatted[1] != "90%" {
+		t.Errorf("Failed! Expected: %v, Got: %v, Error: %v", []string{":10%", "90%"}, formatted, err)
+	}
+}
+
+// TIMESTAMPS:
+// Parses and formats timestamp range
+func TestTimestampRange(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 250000000, time.UTC)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	expectedRange := Range{Start: float64(start.UnixMicro()) / 1e6, End: float64(end.Unix())}
+
+	grange, err := ParseRangeWith("2024-01-02 05:04:05.25+02|2024-02-01", "|", ParseTimestamp)
+	formatted := grange.FormatWith("|", FormatTimestamp)
+
+	if err != nil || grange != expectedRange || formatted != "2024-01-02T03:04:05.25Z|2024-02-01T00:00:00Z" {
+		t.Errorf("Failed! Expected: %v, Got: %v (%s), Error: %v", expectedRange, grange, formatted, err)
+	}
+}